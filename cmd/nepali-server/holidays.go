@@ -0,0 +1,54 @@
+package main
+
+import (
+	"github.com/opensource-nepal/go-nepali/nepalitime"
+)
+
+// holiday on a fixed nepali date
+type fixedHoliday struct {
+	month, day int
+	name       string
+	nameNepali string
+}
+
+// public holidays on the fixed nepali dates.
+// The holidays of the lunar calendar (eg. Dashain, Tihar) change every year
+// and are not included.
+var fixedHolidays = []fixedHoliday{
+	{1, 1, "Nepali New Year", "नयाँ वर्ष"},
+	{1, 11, "Loktantra Diwas", "लोकतन्त्र दिवस"},
+	{2, 15, "Ganatantra Diwas", "गणतन्त्र दिवस"},
+	{6, 3, "Constitution Day", "संविधान दिवस"},
+	{9, 27, "Prithvi Jayanti", "पृथ्वी जयन्ती"},
+	{10, 1, "Maghe Sankranti", "माघे संक्रान्ति"},
+	{10, 16, "Martyrs' Day", "शहीद दिवस"},
+	{11, 7, "Democracy Day", "प्रजातन्त्र दिवस"},
+}
+
+type holiday struct {
+	date       nepalitime.NepaliDate
+	name       string
+	nameNepali string
+}
+
+// holidays of the nepali year
+func holidaysOf(year int) []holiday {
+	var holidays []holiday
+	for _, h := range fixedHolidays {
+		date, err := nepalitime.NewDate(year, h.month, h.day)
+		if err != nil {
+			continue
+		}
+		holidays = append(holidays, holiday{date, h.name, h.nameNepali})
+	}
+	return holidays
+}
+
+// names of the holidays of the nepali year by date
+func holidaysByDate(year int) map[nepalitime.NepaliDate]string {
+	holidays := make(map[nepalitime.NepaliDate]string)
+	for _, h := range holidaysOf(year) {
+		holidays[h.date] = h.name
+	}
+	return holidays
+}
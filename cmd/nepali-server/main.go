@@ -0,0 +1,37 @@
+// Command nepali-server serves the nepali (BS) date conversion, formatting, parsing,
+// month calendar and holidays as an HTTP JSON API.
+//
+// USAGE:
+//
+//	go run ./cmd/nepali-server -addr :8080
+//	curl "localhost:8080/v1/convert/ad-to-bs?date=2023-01-28"
+//
+// The API is described in /openapi.json.
+package main
+
+import (
+	"flag"
+	"log/slog"
+	"net/http"
+	"os"
+	"time"
+)
+
+func main() {
+	addr := flag.String("addr", ":8080", "address to listen on")
+	flag.Parse()
+
+	server := &http.Server{
+		Addr:              *addr,
+		Handler:           newServer(),
+		ReadHeaderTimeout: 5 * time.Second,
+		ReadTimeout:       10 * time.Second,
+		WriteTimeout:      10 * time.Second,
+	}
+
+	slog.Info("listening", "addr", *addr)
+	if err := server.ListenAndServe(); err != nil {
+		slog.Error("server stopped", "error", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,390 @@
+package main
+
+import (
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/opensource-nepal/go-nepali/constants"
+	"github.com/opensource-nepal/go-nepali/dateConverter"
+	"github.com/opensource-nepal/go-nepali/ics"
+	"github.com/opensource-nepal/go-nepali/nepalitime"
+)
+
+//go:embed openapi.json
+var openAPISpec []byte
+
+// route of the API, documented in openapi.json
+type route struct {
+	method  string
+	path    string
+	handler http.HandlerFunc
+}
+
+func routes() []route {
+	return []route{
+		{"GET", "/v1/convert/ad-to-bs", handleADToBS},
+		{"GET", "/v1/convert/bs-to-ad", handleBSToAD},
+		{"GET", "/v1/format", handleFormat},
+		{"GET", "/v1/parse", handleParse},
+		{"GET", "/v1/calendar/{year}/{month}", handleCalendar},
+		{"GET", "/v1/holidays/{year}", handleHolidays},
+		{"GET", "/openapi.json", handleOpenAPI},
+	}
+}
+
+// newServer returns the handler of the API
+func newServer() http.Handler {
+	mux := http.NewServeMux()
+	for _, r := range routes() {
+		mux.HandleFunc(r.method+" "+r.path, r.handler)
+	}
+	return mux
+}
+
+// dateParts is a date of either calendar in the responses
+type dateParts struct {
+	Date      string `json:"date"`
+	Year      int    `json:"year"`
+	Month     int    `json:"month"`
+	Day       int    `json:"day"`
+	MonthName string `json:"monthName"`
+
+	// devanagari month name, only for BS
+	MonthNameNepali string `json:"monthNameNepali,omitempty"`
+}
+
+type dateResponse struct {
+	BS      dateParts `json:"bs"`
+	AD      dateParts `json:"ad"`
+	Weekday string    `json:"weekday"`
+	// RFC 3339 time in Asia/Kathmandu
+	Time string `json:"time"`
+}
+
+func newDateResponse(nt *nepalitime.NepaliTime) dateResponse {
+	year, month, day := nt.Date()
+	enTime := nt.GetEnglishTime()
+
+	return dateResponse{
+		BS: dateParts{
+			Date:            nt.NepaliDate().String(),
+			Year:            year,
+			Month:           month,
+			Day:             day,
+			MonthName:       constants.NepaliMonths[month-1],
+			MonthNameNepali: constants.DevanagariMonths[month-1],
+		},
+		AD: dateParts{
+			Date:      enTime.Format(time.DateOnly),
+			Year:      enTime.Year(),
+			Month:     int(enTime.Month()),
+			Day:       enTime.Day(),
+			MonthName: enTime.Month().String(),
+		},
+		Weekday: nt.Weekday().String(),
+		Time:    enTime.Format(time.RFC3339),
+	}
+}
+
+// GET /v1/convert/ad-to-bs?date=2023-01-28
+// The date can be a date or an RFC 3339 time.
+func handleADToBS(w http.ResponseWriter, r *http.Request) {
+	value, ok := requiredParam(w, r, "date")
+	if !ok {
+		return
+	}
+
+	enTime, err := time.ParseInLocation(time.DateOnly, value, nepalitime.GetNepaliLocation())
+	if err != nil {
+		if enTime, err = time.Parse(time.RFC3339, value); err != nil {
+			writeError(w, http.StatusBadRequest, "invalid_date", `date should be in the form "2006-01-02" or RFC 3339`)
+			return
+		}
+	}
+
+	nt, err := nepalitime.FromEnglishTime(enTime)
+	if err != nil {
+		writeDateError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, newDateResponse(nt))
+}
+
+// GET /v1/convert/bs-to-ad?date=2079-10-14
+// The date can have time too, eg. 2079-10-14T10:30:00
+func handleBSToAD(w http.ResponseWriter, r *http.Request) {
+	nt, ok := requiredBSDate(w, r)
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, newDateResponse(nt))
+}
+
+// GET /v1/format?date=2079-10-14&format=%d %B %Y
+func handleFormat(w http.ResponseWriter, r *http.Request) {
+	format, ok := requiredParam(w, r, "format")
+	if !ok {
+		return
+	}
+	nt, ok := requiredBSDate(w, r)
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]string{"formatted": nt.Format(format)})
+}
+
+type parseResponse struct {
+	dateResponse
+	Layout string `json:"layout"`
+}
+
+// GET /v1/parse?value=14 Magh 2079&format=%d %B %Y&mode=strict
+// The layout is detected if format is empty.
+func handleParse(w http.ResponseWriter, r *http.Request) {
+	value, ok := requiredParam(w, r, "value")
+	if !ok {
+		return
+	}
+
+	var opts nepalitime.ParseOptions
+	switch mode := r.URL.Query().Get("mode"); mode {
+	case "", "default":
+		opts.Mode = nepalitime.DefaultMode
+	case "strict":
+		opts.Mode = nepalitime.StrictMode
+	case "lenient":
+		opts.Mode = nepalitime.LenientMode
+	default:
+		writeError(w, http.StatusBadRequest, "invalid_request", `mode should be "default", "strict" or "lenient"`)
+		return
+	}
+
+	var (
+		nt     *nepalitime.NepaliTime
+		layout = r.URL.Query().Get("format")
+		err    error
+	)
+	if layout == "" {
+		parser := nepalitime.NewAnyParser()
+		parser.Options = opts
+		nt, layout, err = parser.Parse(value)
+	} else {
+		nt, err = nepalitime.ParseWithOptions(value, layout, opts)
+	}
+	if err != nil {
+		writeDateError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, parseResponse{newDateResponse(nt), layout})
+}
+
+type calendarDay struct {
+	Day     int    `json:"day"`
+	AD      string `json:"ad"`
+	Weekday int    `json:"weekday"`
+	Holiday string `json:"holiday,omitempty"`
+}
+
+type calendarResponse struct {
+	Year            int    `json:"year"`
+	Month           int    `json:"month"`
+	MonthName       string `json:"monthName"`
+	MonthNameNepali string `json:"monthNameNepali"`
+	Days            int    `json:"days"`
+
+	// weeks from Sunday to Saturday, null for the days of the other months
+	Weeks [][7]*calendarDay `json:"weeks"`
+}
+
+// GET /v1/calendar/2080/4
+func handleCalendar(w http.ResponseWriter, r *http.Request) {
+	year, err1 := strconv.Atoi(r.PathValue("year"))
+	month, err2 := strconv.Atoi(r.PathValue("month"))
+	if err1 != nil || err2 != nil {
+		writeError(w, http.StatusBadRequest, "invalid_request", "year and month should be numbers")
+		return
+	}
+	if month < 1 || month > 12 {
+		writeError(w, http.StatusBadRequest, "invalid_request", "month should be from 1 to 12")
+		return
+	}
+
+	first, err := nepalitime.NewDate(year, month, 1)
+	if err != nil {
+		writeDateError(w, err)
+		return
+	}
+	monthRange, err := nepalitime.RangeOf(first, nepalitime.Month)
+	if err != nil {
+		writeDateError(w, err)
+		return
+	}
+
+	holidays := holidaysByDate(year)
+	resp := calendarResponse{
+		Year:            year,
+		Month:           month,
+		MonthName:       constants.NepaliMonths[month-1],
+		MonthNameNepali: constants.DevanagariMonths[month-1],
+		Days:            monthRange.Len(),
+	}
+	for week := range monthRange.Weeks() {
+		var cells [7]*calendarDay
+		for date := range week.Days() {
+			cells[date.Weekday()] = &calendarDay{
+				Day:     date.Day(),
+				AD:      date.Time().GetEnglishTime().Format(time.DateOnly),
+				Weekday: int(date.Weekday()),
+				Holiday: holidays[date],
+			}
+		}
+		resp.Weeks = append(resp.Weeks, cells)
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+type holidayResponse struct {
+	Name       string `json:"name"`
+	NameNepali string `json:"nameNepali"`
+	BS         string `json:"bs"`
+	AD         string `json:"ad"`
+	Weekday    string `json:"weekday"`
+}
+
+// GET /v1/holidays/2080 or /v1/holidays/2080.ics
+func handleHolidays(w http.ResponseWriter, r *http.Request) {
+	yearStr, asICS := strings.CutSuffix(r.PathValue("year"), ".ics")
+	year, err := strconv.Atoi(yearStr)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_request", "year should be a number")
+		return
+	}
+	if _, err := dateConverter.NepaliYearDays(year); err != nil {
+		writeDateError(w, err)
+		return
+	}
+
+	if asICS {
+		cal := &ics.Calendar{Name: "Nepali holidays " + yearStr}
+		for _, h := range holidaysOf(year) {
+			event := ics.NewHoliday(h.nameNepali, h.date)
+			event.Description = h.name
+			cal.Events = append(cal.Events, event)
+		}
+		w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+		cal.WriteTo(w)
+		return
+	}
+
+	resp := []holidayResponse{}
+	for _, h := range holidaysOf(year) {
+		resp = append(resp, holidayResponse{
+			Name:       h.name,
+			NameNepali: h.nameNepali,
+			BS:         h.date.String(),
+			AD:         h.date.Time().GetEnglishTime().Format(time.DateOnly),
+			Weekday:    h.date.Weekday().String(),
+		})
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// GET /openapi.json
+func handleOpenAPI(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(openAPISpec)
+}
+
+var bsLayouts = []*nepalitime.Layout{
+	mustCompileStrictLayout("%Y-%m-%d"),
+	mustCompileStrictLayout("%Y-%m-%dT%H:%M:%S"),
+}
+
+func mustCompileStrictLayout(format string) *nepalitime.Layout {
+	layout, err := nepalitime.CompileLayoutWithOptions(format, nepalitime.ParseOptions{Mode: nepalitime.StrictMode})
+	if err != nil {
+		panic(err)
+	}
+	return layout
+}
+
+// parses the "date" query parameter as BS date or time,
+// writes the error response if invalid
+func requiredBSDate(w http.ResponseWriter, r *http.Request) (*nepalitime.NepaliTime, bool) {
+	value, ok := requiredParam(w, r, "date")
+	if !ok {
+		return nil, false
+	}
+
+	var err error
+	for _, layout := range bsLayouts {
+		var nt *nepalitime.NepaliTime
+		if nt, err = layout.Parse(value); err == nil {
+			return nt, true
+		}
+		if errors.Is(err, dateConverter.ErrOutOfRange) {
+			break
+		}
+	}
+
+	if errors.Is(err, dateConverter.ErrOutOfRange) {
+		writeDateError(w, err)
+	} else {
+		writeError(w, http.StatusBadRequest, "invalid_date", `date should be in the form "2079-10-14" or "2079-10-14T10:30:00"`)
+	}
+	return nil, false
+}
+
+func requiredParam(w http.ResponseWriter, r *http.Request, name string) (string, bool) {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		writeError(w, http.StatusBadRequest, "invalid_request", name+" is required")
+		return "", false
+	}
+	return value, true
+}
+
+type errorResponse struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+
+	// matched layouts of an ambiguous value
+	Layouts []string `json:"layouts,omitempty"`
+}
+
+// writes the error response of the date errors of nepalitime and dateConverter
+func writeDateError(w http.ResponseWriter, err error) {
+	var (
+		conflictErr  *nepalitime.FieldConflictError
+		ambiguousErr *nepalitime.AmbiguousError
+	)
+
+	switch {
+	case errors.Is(err, dateConverter.ErrOutOfRange):
+		writeError(w, http.StatusUnprocessableEntity, "out_of_range", err.Error())
+	case errors.As(err, &conflictErr):
+		writeError(w, http.StatusUnprocessableEntity, "field_conflict", err.Error())
+	case errors.As(err, &ambiguousErr):
+		writeJSON(w, http.StatusUnprocessableEntity, errorResponse{"ambiguous", err.Error(), ambiguousErr.Layouts})
+	default:
+		writeError(w, http.StatusBadRequest, "invalid_date", err.Error())
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, code, message string) {
+	writeJSON(w, status, errorResponse{Code: code, Message: message})
+}
+
+func writeJSON(w http.ResponseWriter, status int, value any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(value)
+}
@@ -0,0 +1,267 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/opensource-nepal/go-nepali/ics"
+	"github.com/stretchr/testify/assert"
+)
+
+// sends the GET request to the server, returns the recorded response
+func get(t *testing.T, target string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	rec := httptest.NewRecorder()
+	newServer().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, target, nil))
+	return rec
+}
+
+func decode[T any](t *testing.T, rec *httptest.ResponseRecorder) T {
+	t.Helper()
+
+	var value T
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &value), rec.Body.String())
+	return value
+}
+
+func TestADToBS(t *testing.T) {
+	rec := get(t, "/v1/convert/ad-to-bs?date=2023-01-28")
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	got := decode[dateResponse](t, rec)
+	assert.Equal(t, "2079-10-14", got.BS.Date)
+	assert.Equal(t, 10, got.BS.Month)
+	assert.Equal(t, "Magh", got.BS.MonthName)
+	assert.Equal(t, "माघ", got.BS.MonthNameNepali)
+	assert.Equal(t, "2023-01-28", got.AD.Date)
+	assert.Equal(t, "January", got.AD.MonthName)
+	assert.Equal(t, "Saturday", got.Weekday)
+	assert.Equal(t, "2023-01-28T00:00:00+05:45", got.Time)
+}
+
+func TestADToBSWithRFC3339(t *testing.T) {
+	// 2023-01-27T20:00:00Z is 2023-01-28 01:45 in Nepal
+	rec := get(t, "/v1/convert/ad-to-bs?date=2023-01-27T20:00:00Z")
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	got := decode[dateResponse](t, rec)
+	assert.Equal(t, "2079-10-14", got.BS.Date)
+	assert.Equal(t, "2023-01-28T01:45:00+05:45", got.Time)
+}
+
+func TestBSToAD(t *testing.T) {
+	rec := get(t, "/v1/convert/bs-to-ad?date=2079-10-14T10:30:00")
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	got := decode[dateResponse](t, rec)
+	assert.Equal(t, "2023-01-28", got.AD.Date)
+	assert.Equal(t, 2079, got.BS.Year)
+	assert.Equal(t, "2023-01-28T10:30:00+05:45", got.Time)
+}
+
+func TestConvertErrors(t *testing.T) {
+	testCases := []struct {
+		target string
+		status int
+		code   string
+	}{
+		{"/v1/convert/ad-to-bs", http.StatusBadRequest, "invalid_request"},
+		{"/v1/convert/ad-to-bs?date=28/01/2023", http.StatusBadRequest, "invalid_date"},
+		{"/v1/convert/ad-to-bs?date=1900-01-01", http.StatusUnprocessableEntity, "out_of_range"},
+		{"/v1/convert/bs-to-ad?date=2079-13-01", http.StatusBadRequest, "invalid_date"},
+		{"/v1/convert/bs-to-ad?date=2079-10-40", http.StatusBadRequest, "invalid_date"},
+		{"/v1/convert/bs-to-ad?date=1900-01-01", http.StatusUnprocessableEntity, "out_of_range"},
+	}
+
+	for _, tc := range testCases {
+		rec := get(t, tc.target)
+		assert.Equal(t, tc.status, rec.Code, tc.target)
+		assert.Equal(t, tc.code, decode[errorResponse](t, rec).Code, tc.target)
+	}
+}
+
+func TestFormat(t *testing.T) {
+	rec := get(t, "/v1/format?date=2079-10-14&format="+url.QueryEscape("%d %B %Y, %A"))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, map[string]string{"formatted": "14 Magh 2079, Saturday"}, decode[map[string]string](t, rec))
+
+	rec = get(t, "/v1/format?date=2079-10-14")
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Equal(t, "format is required", decode[errorResponse](t, rec).Message)
+}
+
+func TestParse(t *testing.T) {
+	rec := get(t, "/v1/parse?value="+url.QueryEscape("14 Magh 2079")+"&format="+url.QueryEscape("%d %B %Y"))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	got := decode[parseResponse](t, rec)
+	assert.Equal(t, "2079-10-14", got.BS.Date)
+	assert.Equal(t, "2023-01-28", got.AD.Date)
+	assert.Equal(t, "%d %B %Y", got.Layout)
+}
+
+func TestParseDetectsLayout(t *testing.T) {
+	rec := get(t, "/v1/parse?value="+url.QueryEscape("2079-10-14 16:23:17"))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	got := decode[parseResponse](t, rec)
+	assert.Equal(t, "2023-01-28T16:23:17+05:45", got.Time)
+	assert.NotEmpty(t, got.Layout)
+}
+
+func TestParseAmbiguous(t *testing.T) {
+	rec := get(t, "/v1/parse?value="+url.QueryEscape("05/06/2079"))
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+
+	got := decode[errorResponse](t, rec)
+	assert.Equal(t, "ambiguous", got.Code)
+	assert.Len(t, got.Layouts, 2)
+}
+
+func TestParseFieldConflict(t *testing.T) {
+	rec := get(t, "/v1/parse?value="+url.QueryEscape("Sunday 2079/10/14")+"&format="+url.QueryEscape("%A %Y/%m/%d"))
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+
+	got := decode[errorResponse](t, rec)
+	assert.Equal(t, "field_conflict", got.Code)
+	assert.Equal(t, `%A is "Sunday" but it should be "Saturday" according to the date`, got.Message)
+}
+
+func TestParseErrors(t *testing.T) {
+	testCases := []struct {
+		target string
+		status int
+		code   string
+	}{
+		{"/v1/parse", http.StatusBadRequest, "invalid_request"},
+		{"/v1/parse?value=2079-10-14&mode=loose", http.StatusBadRequest, "invalid_request"},
+		{"/v1/parse?value=hello", http.StatusBadRequest, "invalid_date"},
+		{"/v1/parse?value=2079-10-14&format=%25d", http.StatusBadRequest, "invalid_date"},
+	}
+
+	for _, tc := range testCases {
+		rec := get(t, tc.target)
+		assert.Equal(t, tc.status, rec.Code, tc.target)
+		assert.Equal(t, tc.code, decode[errorResponse](t, rec).Code, tc.target)
+	}
+}
+
+func TestCalendar(t *testing.T) {
+	rec := get(t, "/v1/calendar/2080/1")
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	got := decode[calendarResponse](t, rec)
+	assert.Equal(t, "Baisakh", got.MonthName)
+	assert.Equal(t, "बैशाख", got.MonthNameNepali)
+	assert.Equal(t, 31, got.Days)
+
+	// 2080-01-01 is a Friday
+	assert.Len(t, got.Weeks, 6)
+	for i := range 5 {
+		assert.Nil(t, got.Weeks[0][i])
+	}
+	first := got.Weeks[0][5]
+	if assert.NotNil(t, first) {
+		assert.Equal(t, calendarDay{Day: 1, AD: "2023-04-14", Weekday: 5, Holiday: "Nepali New Year"}, *first)
+	}
+	assert.Equal(t, 2, got.Weeks[0][6].Day)
+	assert.Equal(t, 30, got.Weeks[4][6].Day)
+	assert.Equal(t, 31, got.Weeks[5][0].Day)
+	assert.Nil(t, got.Weeks[5][1])
+
+	count := 0
+	for _, week := range got.Weeks {
+		for _, day := range week {
+			if day != nil {
+				count++
+			}
+		}
+	}
+	assert.Equal(t, 31, count)
+}
+
+func TestCalendarErrors(t *testing.T) {
+	testCases := []struct {
+		target string
+		status int
+		code   string
+	}{
+		{"/v1/calendar/2080/baisakh", http.StatusBadRequest, "invalid_request"},
+		{"/v1/calendar/2080/13", http.StatusBadRequest, "invalid_request"},
+		{"/v1/calendar/1900/1", http.StatusUnprocessableEntity, "out_of_range"},
+	}
+
+	for _, tc := range testCases {
+		rec := get(t, tc.target)
+		assert.Equal(t, tc.status, rec.Code, tc.target)
+		assert.Equal(t, tc.code, decode[errorResponse](t, rec).Code, tc.target)
+	}
+}
+
+func TestHolidays(t *testing.T) {
+	rec := get(t, "/v1/holidays/2080")
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	got := decode[[]holidayResponse](t, rec)
+	assert.Len(t, got, len(fixedHolidays))
+	assert.Equal(t, holidayResponse{
+		Name:       "Nepali New Year",
+		NameNepali: "नयाँ वर्ष",
+		BS:         "2080-01-01",
+		AD:         "2023-04-14",
+		Weekday:    "Friday",
+	}, got[0])
+}
+
+func TestHolidaysAsICS(t *testing.T) {
+	rec := get(t, "/v1/holidays/2080.ics")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "text/calendar; charset=utf-8", rec.Header().Get("Content-Type"))
+
+	cal, err := ics.Parse(rec.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, "Nepali holidays 2080", cal.Name)
+	if assert.Len(t, cal.Events, len(fixedHolidays)) {
+		assert.Equal(t, "नयाँ वर्ष", cal.Events[0].Summary)
+		assert.Equal(t, "2080-01-01", cal.Events[0].Start.NepaliDate().String())
+		assert.True(t, cal.Events[0].AllDay)
+	}
+}
+
+func TestHolidaysErrors(t *testing.T) {
+	rec := get(t, "/v1/holidays/next")
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec = get(t, "/v1/holidays/1900")
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	assert.Equal(t, "out_of_range", decode[errorResponse](t, rec).Code)
+}
+
+func TestMethodNotAllowed(t *testing.T) {
+	rec := httptest.NewRecorder()
+	newServer().ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/v1/convert/ad-to-bs", strings.NewReader("")))
+
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
+
+func TestOpenAPIDescribesAllRoutes(t *testing.T) {
+	rec := get(t, "/openapi.json")
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	spec := decode[struct {
+		Paths map[string]map[string]any `json:"paths"`
+	}](t, rec)
+
+	assert.Len(t, spec.Paths, len(routes()))
+	for _, r := range routes() {
+		if assert.Contains(t, spec.Paths, r.path) {
+			assert.Contains(t, spec.Paths[r.path], strings.ToLower(r.method), r.path)
+		}
+	}
+}
@@ -6,4 +6,10 @@ const (
 
 var (
 	NepaliMonths = [12]string{"Baisakh", "Jestha", "Ashadh", "Shrawan", "Bhadra", "Ashwin", "Kartik", "Mangsir", "Poush", "Magh", "Falgun", "Chaitra"}
+
+	DevanagariMonths = [12]string{"बैशाख", "जेठ", "असार", "साउन", "भदौ", "असोज", "कात्तिक", "मंसिर", "पुस", "माघ", "फागुन", "चैत"}
+
+	DevanagariWeekdays = [7]string{"आइतबार", "सोमबार", "मंगलबार", "बुधबार", "बिहीबार", "शुक्रबार", "शनिबार"}
+
+	DevanagariDigits = [10]string{"०", "१", "२", "३", "४", "५", "६", "७", "८", "९"}
 )
@@ -0,0 +1,396 @@
+// Package cron
+// This package contains the cron expressions evaluated in the nepali calendar.
+//
+// The day of month and month fields are nepali (BS) and the schedule runs in Asia/Kathmandu, eg.
+// "0 9 15 3 *" is 09:00 of every Ashadh 15 and
+// "0 18 LW * *" is 18:00 of the last working day of every nepali month.
+//
+// USAGE:
+//
+//	schedule, err := cron.Parse("0 9 15 Ashadh *")
+//	next := schedule.Next(nepalitime.Now())
+package cron
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/opensource-nepal/go-nepali/constants"
+	"github.com/opensource-nepal/go-nepali/nepalitime"
+)
+
+// Schedule is a parsed cron expression.
+//
+// The expression has 5 fields separated by spaces:
+//
+//	minute        0-59
+//	hour          0-23
+//	day of month  1-32, L (last day), L-n (n days before the last day), LW (last working day)
+//	month         1-12 or nepali month names (Baisakh to Chaitra)
+//	day of week   0-6 (0 or 7 is Sunday) or SUN-SAT, nL (last weekday n of the month), n#k (kth weekday n of the month)
+//
+// The fields support "*", lists "1,15", ranges "1-5" and steps "*/15" or "1-10/2".
+// Like the standard cron, when both day of month and day of week are restricted,
+// a day matching any one of them is matched.
+//
+// The predefined schedules @yearly (or @annually), @monthly, @weekly, @daily (or @midnight)
+// and @hourly are supported too.
+type Schedule struct {
+	expr string
+
+	minutes, hours, days, months, weekdays uint64
+
+	// day of month
+	lastDays    []int // days before the last day, 0 for L
+	lastWorking bool  // LW
+
+	// day of week
+	nthWeekdays []nthWeekday
+
+	daysStar, weekdaysStar bool
+
+	// Weekend days, skipped by LW. Saturday by default.
+	Weekend []time.Weekday
+}
+
+// weekday of the month, n is -1 for the last
+type nthWeekday struct {
+	weekday time.Weekday
+	n       int
+}
+
+var predefined = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+var weekdayNames = []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}
+
+// Parse parses the cron expression, see Schedule for the syntax.
+func Parse(expr string) (*Schedule, error) {
+	fields := strings.Fields(expr)
+	if len(fields) == 1 {
+		if value, ok := predefined[strings.ToLower(fields[0])]; ok {
+			fields = strings.Fields(value)
+		}
+	}
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("expected 5 fields in %q, found %d", expr, len(fields))
+	}
+
+	schedule := &Schedule{expr: expr, Weekend: []time.Weekday{time.Saturday}}
+
+	var err error
+	if schedule.minutes, err = parseField(fields[0], 0, 59, nil); err != nil {
+		return nil, fmt.Errorf("invalid value in minute: %w", err)
+	}
+	if schedule.hours, err = parseField(fields[1], 0, 23, nil); err != nil {
+		return nil, fmt.Errorf("invalid value in hour: %w", err)
+	}
+	if err = schedule.parseDays(fields[2]); err != nil {
+		return nil, fmt.Errorf("invalid value in day of month: %w", err)
+	}
+	if schedule.months, err = parseField(fields[3], 1, 12, monthNumber); err != nil {
+		return nil, fmt.Errorf("invalid value in month: %w", err)
+	}
+	if err = schedule.parseWeekdays(fields[4]); err != nil {
+		return nil, fmt.Errorf("invalid value in day of week: %w", err)
+	}
+
+	return schedule, nil
+}
+
+// MustParse is like Parse but panics if the expression is invalid.
+// It simplifies initialization of global variables holding schedules.
+func MustParse(expr string) *Schedule {
+	schedule, err := Parse(expr)
+	if err != nil {
+		panic("cron: MustParse(" + expr + "): " + err.Error())
+	}
+	return schedule
+}
+
+// String returns the cron expression.
+func (s *Schedule) String() string {
+	return s.expr
+}
+
+// Next returns the first time matching the schedule after the given time (exclusive),
+// in Asia/Kathmandu. Returns nil if there is no such time in the supported range.
+func (s *Schedule) Next(after *nepalitime.NepaliTime) *nepalitime.NepaliTime {
+	loc := nepalitime.GetNepaliLocation()
+
+	// the next minute
+	enTime := after.GetEnglishTime().In(loc).Truncate(time.Minute).Add(time.Minute)
+	start, err := nepalitime.FromEnglishTime(enTime)
+	if err != nil {
+		return nil
+	}
+
+	date := start.NepaliDate()
+	hour, minute := start.Hour(), start.Minute()
+	for {
+		if s.months&(1<<date.Month()) == 0 {
+			// skipping to the next month
+			year, month := date.Year(), date.Month()+1
+			if month > 12 {
+				year, month = year+1, 1
+			}
+			if date, err = nepalitime.NewDate(year, month, 1); err != nil {
+				return nil
+			}
+			hour, minute = 0, 0
+			continue
+		}
+
+		if s.matchesDay(date) {
+			if h, m, ok := s.nextClock(hour, minute); ok {
+				year, month, day := date.Date()
+				nt, err := nepalitime.DateIn(year, month, day, h, m, 0, 0, loc)
+				if err != nil {
+					return nil
+				}
+				return nt
+			}
+		}
+
+		if date, err = date.AddDays(1); err != nil {
+			return nil
+		}
+		hour, minute = 0, 0
+	}
+}
+
+// returns the first matching hour and minute at or after the given clock of the day
+func (s *Schedule) nextClock(hour, minute int) (int, int, bool) {
+	for h := hour; h < 24; h++ {
+		if s.hours&(1<<h) == 0 {
+			continue
+		}
+		m := 0
+		if h == hour {
+			m = minute
+		}
+		for ; m < 60; m++ {
+			if s.minutes&(1<<m) != 0 {
+				return h, m, true
+			}
+		}
+	}
+	return 0, 0, false
+}
+
+// checks the day of month and day of week fields
+func (s *Schedule) matchesDay(date nepalitime.NepaliDate) bool {
+	dayMatch := s.matchesDayOfMonth(date)
+	weekdayMatch := s.matchesDayOfWeek(date)
+
+	if s.daysStar || s.weekdaysStar {
+		return dayMatch && weekdayMatch
+	}
+	return dayMatch || weekdayMatch
+}
+
+func (s *Schedule) matchesDayOfMonth(date nepalitime.NepaliDate) bool {
+	if s.days&(1<<date.Day()) != 0 {
+		return true
+	}
+
+	lastDay := date.DaysInMonth()
+	if slices.Contains(s.lastDays, lastDay-date.Day()) {
+		return true
+	}
+	if s.lastWorking {
+		// last day of the month which is not a weekend
+		day := lastDay
+		for day > 1 && slices.Contains(s.Weekend, weekdayOfDay(date, day)) {
+			day--
+		}
+		return date.Day() == day
+	}
+
+	return false
+}
+
+func (s *Schedule) matchesDayOfWeek(date nepalitime.NepaliDate) bool {
+	weekday := date.Weekday()
+	if s.weekdays&(1<<weekday) != 0 {
+		return true
+	}
+
+	for _, nth := range s.nthWeekdays {
+		if nth.weekday != weekday {
+			continue
+		}
+		if nth.n == -1 && date.Day()+7 > date.DaysInMonth() {
+			return true
+		}
+		if nth.n == (date.Day()-1)/7+1 {
+			return true
+		}
+	}
+	return false
+}
+
+// weekday of another day of the same month
+func weekdayOfDay(date nepalitime.NepaliDate, day int) time.Weekday {
+	return time.Weekday((int(date.Weekday()) + day - date.Day() + 7*5) % 7)
+}
+
+func (s *Schedule) parseDays(field string) error {
+	s.daysStar = field == "*" || field == "?"
+
+	var rest []string
+	for _, part := range strings.Split(field, ",") {
+		switch upper := strings.ToUpper(part); {
+		case upper == "L":
+			s.lastDays = append(s.lastDays, 0)
+		case upper == "LW":
+			s.lastWorking = true
+		case strings.HasPrefix(upper, "L-"):
+			n, err := strconv.Atoi(upper[2:])
+			if err != nil || n < 0 || n > 31 {
+				return fmt.Errorf("invalid value %q", part)
+			}
+			s.lastDays = append(s.lastDays, n)
+		default:
+			rest = append(rest, part)
+		}
+	}
+
+	if len(rest) > 0 {
+		days, err := parseField(strings.Join(rest, ","), 1, 32, nil)
+		if err != nil {
+			return err
+		}
+		s.days = days
+	}
+	return nil
+}
+
+func (s *Schedule) parseWeekdays(field string) error {
+	s.weekdaysStar = field == "*" || field == "?"
+
+	var rest []string
+	for _, part := range strings.Split(field, ",") {
+		upper := strings.ToUpper(part)
+		if weekday, n, ok := strings.Cut(upper, "#"); ok {
+			day, err1 := weekdayNumber(weekday)
+			nth, err2 := strconv.Atoi(n)
+			if err1 != nil || err2 != nil || nth < 1 || nth > 5 {
+				return fmt.Errorf("invalid value %q", part)
+			}
+			s.nthWeekdays = append(s.nthWeekdays, nthWeekday{time.Weekday(day % 7), nth})
+		} else if weekday, ok := strings.CutSuffix(upper, "L"); ok && weekday != "" {
+			day, err := weekdayNumber(weekday)
+			if err != nil {
+				return fmt.Errorf("invalid value %q", part)
+			}
+			s.nthWeekdays = append(s.nthWeekdays, nthWeekday{time.Weekday(day % 7), -1})
+		} else {
+			rest = append(rest, part)
+		}
+	}
+
+	if len(rest) > 0 {
+		weekdays, err := parseField(strings.Join(rest, ","), 0, 7, weekdayNumber)
+		if err != nil {
+			return err
+		}
+		// 7 is Sunday
+		if weekdays&(1<<7) != 0 {
+			weekdays |= 1
+		}
+		s.weekdays = weekdays
+	}
+	return nil
+}
+
+// parses the field into the bits of the values in the range [min, max],
+// names are converted into numbers with the name function if not nil
+func parseField(field string, min, max int, name func(string) (int, error)) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(field, ",") {
+		rangePart, stepPart, hasStep := strings.Cut(part, "/")
+
+		step := 1
+		if hasStep {
+			var err error
+			if step, err = strconv.Atoi(stepPart); err != nil || step < 1 {
+				return 0, fmt.Errorf("invalid step %q", part)
+			}
+		}
+
+		start, end := min, max
+		if rangePart != "*" && rangePart != "?" {
+			startPart, endPart, isRange := strings.Cut(rangePart, "-")
+
+			var err error
+			if start, err = parseValue(startPart, min, max, name); err != nil {
+				return 0, err
+			}
+			end = start
+			if isRange {
+				if end, err = parseValue(endPart, min, max, name); err != nil {
+					return 0, err
+				}
+			} else if hasStep {
+				end = max
+			}
+			if end < start {
+				return 0, fmt.Errorf("invalid range %q", part)
+			}
+		}
+
+		for value := start; value <= end; value += step {
+			bits |= 1 << value
+		}
+	}
+	return bits, nil
+}
+
+func parseValue(value string, min, max int, name func(string) (int, error)) (int, error) {
+	n, err := strconv.Atoi(value)
+	if err != nil && name != nil {
+		n, err = name(value)
+	}
+	if err != nil || n < min || n > max {
+		return 0, fmt.Errorf("value %q is out of range", value)
+	}
+	return n, nil
+}
+
+// number of the nepali month name, case-insensitive
+func monthNumber(name string) (int, error) {
+	for i, month := range constants.NepaliMonths {
+		if strings.EqualFold(month, name) {
+			return i + 1, nil
+		}
+	}
+	return 0, errors.New("unknown month")
+}
+
+// number of the weekday name (SUN to SAT) or number (0 to 7)
+func weekdayNumber(name string) (int, error) {
+	if n, err := strconv.Atoi(name); err == nil {
+		if n < 0 || n > 7 {
+			return 0, errors.New("unknown weekday")
+		}
+		return n, nil
+	}
+
+	index := slices.Index(weekdayNames, strings.ToUpper(name))
+	if index == -1 {
+		return 0, errors.New("unknown weekday")
+	}
+	return index, nil
+}
@@ -0,0 +1,112 @@
+package cron_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/opensource-nepal/go-nepali/cron"
+	"github.com/opensource-nepal/go-nepali/nepalitime"
+	"github.com/stretchr/testify/assert"
+)
+
+func mustTime(t *testing.T, year, month, day, hour, min int) *nepalitime.NepaliTime {
+	t.Helper()
+
+	nt, err := nepalitime.Date(year, month, day, hour, min, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return nt
+}
+
+func TestNext(t *testing.T) {
+	testCases := []struct {
+		expr     string
+		after    *nepalitime.NepaliTime
+		expected string
+	}{
+		{"0 9 15 Ashadh *", mustTime(t, 2080, 1, 1, 0, 0), "2080-03-15 09:00:00"},
+		{"0 9 15 3 *", mustTime(t, 2080, 3, 15, 9, 0), "2081-03-15 09:00:00"},
+		{"0 9 15 3 *", mustTime(t, 2080, 3, 15, 8, 59), "2080-03-15 09:00:00"},
+		{"*/15 * * * *", mustTime(t, 2080, 1, 1, 10, 7), "2080-01-01 10:15:00"},
+		{"0 0 L * *", mustTime(t, 2080, 2, 1, 0, 0), "2080-02-32 00:00:00"},
+		{"30 10 L-1 * *", mustTime(t, 2080, 2, 1, 0, 0), "2080-02-31 10:30:00"},
+		{"0 0 L 9 *", mustTime(t, 2080, 1, 1, 0, 0), "2080-09-29 00:00:00"},
+		// Baisakh 2080 ends on Sunday and Mangsir 2080 on Saturday
+		{"0 18 LW * *", mustTime(t, 2080, 1, 1, 0, 0), "2080-01-31 18:00:00"},
+		{"0 18 LW * *", mustTime(t, 2080, 8, 1, 0, 0), "2080-08-29 18:00:00"},
+		{"0 18 LW * *", mustTime(t, 2080, 8, 29, 18, 0), "2080-09-29 18:00:00"},
+		// fridays of Baisakh 2080 are 1, 8, 15, 22 and 29
+		{"0 10 * * 5L", mustTime(t, 2080, 1, 1, 0, 0), "2080-01-29 10:00:00"},
+		{"0 10 * * FRI#2", mustTime(t, 2080, 1, 1, 0, 0), "2080-01-08 10:00:00"},
+		// either day of month or day of week
+		{"0 0 1 * MON", mustTime(t, 2080, 1, 1, 0, 0), "2080-01-04 00:00:00"},
+		{"0 0 * * 7", mustTime(t, 2080, 1, 1, 0, 0), "2080-01-03 00:00:00"},
+		{"@monthly", mustTime(t, 2080, 1, 1, 0, 0), "2080-02-01 00:00:00"},
+		{"@yearly", mustTime(t, 2080, 1, 1, 0, 0), "2081-01-01 00:00:00"},
+		{"0 6-8/2 1,15 Baisakh-Jestha *", mustTime(t, 2080, 1, 1, 6, 30), "2080-01-01 08:00:00"},
+	}
+
+	for _, tc := range testCases {
+		schedule, err := cron.Parse(tc.expr)
+		if !assert.Nil(t, err, tc.expr) {
+			continue
+		}
+
+		next := schedule.Next(tc.after)
+		if assert.NotNil(t, next, tc.expr) {
+			assert.Equal(t, tc.expected, next.String(), tc.expr)
+		}
+	}
+}
+
+func TestNextInAsiaKathmandu(t *testing.T) {
+	// 2080-03-15 01:45 in Asia/Kathmandu
+	after, _ := nepalitime.DateIn(2080, 3, 14, 20, 0, 0, 0, time.UTC)
+
+	next := cron.MustParse("0 9 15 Ashadh *").Next(after)
+	assert.Equal(t, "2080-03-15 09:00:00", next.String())
+	assert.Equal(t, nepalitime.GetNepaliLocation(), next.GetEnglishTime().Location())
+}
+
+func TestNextWithCustomWeekend(t *testing.T) {
+	schedule := cron.MustParse("0 18 LW * *")
+	schedule.Weekend = []time.Weekday{time.Saturday, time.Sunday}
+
+	// Poush 2080 ends on Sunday
+	next := schedule.Next(mustTime(t, 2080, 9, 1, 0, 0))
+	assert.Equal(t, "2080-09-27 18:00:00", next.String())
+}
+
+func TestNextOutOfRange(t *testing.T) {
+	assert.Nil(t, cron.MustParse("@yearly").Next(mustTime(t, 2099, 2, 1, 0, 0)))
+}
+
+func TestParseWithInvalidExpression(t *testing.T) {
+	for _, expr := range []string{
+		"",
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 33 * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * Magha *",
+		"* * * * 8",
+		"* * * * FUN",
+		"* * L-x * *",
+		"*/0 * * * *",
+		"5-1 * * * *",
+		"* * * * MON#6",
+		"@every",
+	} {
+		_, err := cron.Parse(expr)
+		assert.NotNil(t, err, expr)
+	}
+
+	assert.Panics(t, func() { cron.MustParse("* * *") })
+}
+
+func TestScheduleString(t *testing.T) {
+	assert.Equal(t, "0 18 LW * *", cron.MustParse("0 18 LW * *").String())
+}
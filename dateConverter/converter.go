@@ -11,6 +11,10 @@ import (
 	"math"
 )
 
+// ErrOutOfRange is returned when a date is outside the supported range
+// or is not a valid date of its calendar.
+var ErrOutOfRange = errors.New("date is out of range")
+
 // Reference date for conversion is 2000/01/01 BS and 1943/4/14 AD
 var npInitialYear int16 = 2000
 var referenceEnDate = [3]int16{1943, 4, 14}
@@ -253,7 +257,7 @@ func EnglishToNepali(year int, month int, day int) (*[3]int, error) {
 	// VALIDATION
 	// checking if date is in range
 	if !checkEnglishDate(year, month, day) {
-		return nil, errors.New("date is out of range")
+		return nil, ErrOutOfRange
 	}
 
 	// REFERENCE
@@ -303,7 +307,7 @@ func NepaliToEnglish(year int, month int, day int) (*[3]int, error) {
 	// VALIDATION
 	// checking if date is in range
 	if !checkNepaliDate(year, month, day) {
-		return nil, errors.New("date is out of range")
+		return nil, ErrOutOfRange
 	}
 
 	// REFERENCE
@@ -345,3 +349,46 @@ func NepaliToEnglish(year int, month int, day int) (*[3]int, error) {
 
 	return &[3]int{enYear, enMonth, enDay}, nil
 }
+
+// NepaliMinYear returns the first nepali year supported by the converter.
+func NepaliMinYear() int {
+	return npMinYear()
+}
+
+// NepaliMaxYear returns the last nepali year supported by the converter.
+func NepaliMaxYear() int {
+	return npMaxYear()
+}
+
+// EnglishMinYear returns the first english year supported by the converter.
+func EnglishMinYear() int {
+	return enMinYear()
+}
+
+// EnglishMaxYear returns the last english year supported by the converter.
+func EnglishMaxYear() int {
+	return enMaxYear()
+}
+
+// IsValidNepaliDate checks if the nepali date exists and is within range.
+// Unlike english months, nepali month lengths vary between 29 and 32 days
+// from year to year.
+func IsValidNepaliDate(year int, month int, day int) bool {
+	return checkNepaliDate(year, month, day)
+}
+
+// NepaliMonthDays returns the number of days in the given nepali month.
+func NepaliMonthDays(year int, month int) (int, error) {
+	if year < npMinYear() || year > npMaxYear() || month < 1 || month > 12 {
+		return 0, ErrOutOfRange
+	}
+	return int(npMonthData[year-int(npInitialYear)].monthData[month-1]), nil
+}
+
+// NepaliYearDays returns the number of days in the given nepali year (365 or 366).
+func NepaliYearDays(year int) (int, error) {
+	if year < npMinYear() || year > npMaxYear() {
+		return 0, ErrOutOfRange
+	}
+	return int(npMonthData[year-int(npInitialYear)].yearDays), nil
+}
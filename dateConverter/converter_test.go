@@ -152,3 +152,64 @@ func TestNepaliToEnglishForMaxEdgeDate(t *testing.T) {
 	assert.Nil(t, err)
 	assert.EqualValues(t, *date, [3]int{2043, 4, 13})
 }
+
+func TestNepaliToEnglishReturnsErrOutOfRange(t *testing.T) {
+	_, err := dateConverter.NepaliToEnglish(2079, 1, 40)
+	assert.ErrorIs(t, err, dateConverter.ErrOutOfRange)
+}
+
+// Supported range
+
+func TestNepaliYearRange(t *testing.T) {
+	assert.Equal(t, 2000, dateConverter.NepaliMinYear())
+	assert.Equal(t, 2099, dateConverter.NepaliMaxYear())
+}
+
+func TestEnglishYearRange(t *testing.T) {
+	assert.Equal(t, 1944, dateConverter.EnglishMinYear())
+	assert.Equal(t, 2042, dateConverter.EnglishMaxYear())
+}
+
+// Month and year data
+
+func TestIsValidNepaliDateForDay32(t *testing.T) {
+	assert.True(t, dateConverter.IsValidNepaliDate(2079, 3, 32))
+	assert.False(t, dateConverter.IsValidNepaliDate(2079, 1, 32))
+	assert.False(t, dateConverter.IsValidNepaliDate(2100, 1, 1))
+}
+
+func TestNepaliMonthDays(t *testing.T) {
+	days, err := dateConverter.NepaliMonthDays(2079, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, 32, days)
+
+	days, err = dateConverter.NepaliMonthDays(2080, 12)
+	assert.Nil(t, err)
+	assert.Equal(t, 30, days)
+}
+
+func TestNepaliMonthDaysReturnErrorOnInvalidMonth(t *testing.T) {
+	_, err := dateConverter.NepaliMonthDays(2079, 13)
+	assert.ErrorIs(t, err, dateConverter.ErrOutOfRange)
+}
+
+func TestNepaliYearDays(t *testing.T) {
+	days, err := dateConverter.NepaliYearDays(2081)
+	assert.Nil(t, err)
+	assert.Equal(t, 366, days)
+
+	_, err = dateConverter.NepaliYearDays(1999)
+	assert.ErrorIs(t, err, dateConverter.ErrOutOfRange)
+}
+
+func TestNepaliYearDaysMatchesMonthDays(t *testing.T) {
+	for year := dateConverter.NepaliMinYear(); year <= dateConverter.NepaliMaxYear(); year++ {
+		total := 0
+		for month := 1; month <= 12; month++ {
+			days, _ := dateConverter.NepaliMonthDays(year, month)
+			total += days
+		}
+		yearDays, _ := dateConverter.NepaliYearDays(year)
+		assert.Equal(t, yearDays, total, "year %d", year)
+	}
+}
@@ -0,0 +1,256 @@
+// Package ics
+// This package contains the iCalendar (RFC 5545) export and import of the events dated in the nepali calendar.
+//
+// The events are written with the AD (english) DTSTART and DTEND, so any calendar application can show them,
+// and the BS dates are kept in the X-NEPALI-DTSTART and X-NEPALI-DTEND properties.
+//
+// USAGE:
+//
+//	cal := &ics.Calendar{Name: "Office"}
+//	cal.Events = append(cal.Events, ics.NewHoliday("नयाँ वर्ष", date))
+//	_, err := cal.WriteTo(file)
+package ics
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/opensource-nepal/go-nepali/nepalitime"
+	"github.com/opensource-nepal/go-nepali/recurrence"
+)
+
+// DefaultProdID is the PRODID of the calendars without one
+const DefaultProdID = "-//opensource-nepal//go-nepali//EN"
+
+// DefaultMaxOccurrences is the number of occurrences written for a recurring event
+// when Calendar.MaxOccurrences is 0.
+const DefaultMaxOccurrences = 100
+
+// Calendar is an iCalendar object (VCALENDAR) with the events.
+type Calendar struct {
+	ProdID string // DefaultProdID if empty
+	Name   string // X-WR-CALNAME, optional
+
+	Events []Event
+
+	// MaxOccurrences is the maximum number of occurrences written for a recurring event,
+	// DefaultMaxOccurrences if 0.
+	//
+	// The recurrence rules of the nepali calendar can't be written as RRULE since
+	// the calendar applications evaluate RRULE in the gregorian calendar,
+	// so the occurrences are written as RDATE.
+	MaxOccurrences int
+}
+
+// Event is an iCalendar event (VEVENT) dated in the nepali calendar.
+type Event struct {
+	UID         string // generated from the summary and the start if empty
+	Summary     string
+	Description string
+	Location    string
+
+	// Start of the event, the date at midnight for an all-day event.
+	Start *nepalitime.NepaliTime
+
+	// End of the event (exclusive), optional.
+	// For an all-day event, it is the last day of the event (inclusive), nil for a single day.
+	End *nepalitime.NepaliTime
+
+	AllDay bool
+
+	// Recurrence of the event in the nepali calendar, optional.
+	// It is written as RDATE with the rule in X-NEPALI-RRULE.
+	Recurrence *recurrence.Rule
+
+	// RRule is the gregorian RRULE of an imported event, it isn't expanded.
+	// It is written as it is.
+	RRule string
+}
+
+// NewHoliday returns the all-day event of the holiday on the nepali date.
+func NewHoliday(summary string, date nepalitime.NepaliDate) Event {
+	return Event{Summary: summary, Start: date.Time(), AllDay: true}
+}
+
+// WriteTo writes the calendar in the iCalendar format to w.
+// It implements io.WriterTo.
+func (c *Calendar) WriteTo(w io.Writer) (int64, error) {
+	var buf bytes.Buffer
+	enc := &encoder{buf: &buf}
+
+	prodID := c.ProdID
+	if prodID == "" {
+		prodID = DefaultProdID
+	}
+
+	enc.line("BEGIN", "VCALENDAR")
+	enc.line("VERSION", "2.0")
+	enc.line("PRODID", prodID)
+	enc.line("CALSCALE", "GREGORIAN")
+	if c.Name != "" {
+		enc.line("X-WR-CALNAME", escapeText(c.Name))
+	}
+
+	stamp := nepalitime.GetCurrentEnglishTime()
+	for _, event := range c.Events {
+		c.writeEvent(enc, event, stamp)
+	}
+
+	enc.line("END", "VCALENDAR")
+
+	n, err := w.Write(buf.Bytes())
+	return int64(n), err
+}
+
+// String returns the calendar in the iCalendar format.
+func (c *Calendar) String() string {
+	var builder strings.Builder
+	c.WriteTo(&builder)
+	return builder.String()
+}
+
+func (c *Calendar) writeEvent(enc *encoder, event Event, stamp time.Time) {
+	if event.Start == nil {
+		return
+	}
+
+	occurrences := []*nepalitime.NepaliTime{event.Start}
+	if event.Recurrence != nil {
+		occurrences = c.occurrences(event)
+		if len(occurrences) == 0 {
+			return
+		}
+	}
+	start := occurrences[0]
+
+	enc.line("BEGIN", "VEVENT")
+	enc.line("UID", event.uid())
+	enc.line("DTSTAMP", formatUTC(stamp))
+
+	if event.AllDay {
+		days := 1
+		if event.End != nil {
+			days = event.End.NepaliDate().Sub(event.Start.NepaliDate()) + 1
+		}
+		end := start.GetEnglishTime().AddDate(0, 0, days)
+
+		enc.line("DTSTART;VALUE=DATE", formatDate(start.GetEnglishTime()))
+		enc.line("DTEND;VALUE=DATE", formatDate(end))
+		enc.line("X-NEPALI-DTSTART;VALUE=DATE", start.Format("%Y%m%d"))
+		if endDate, err := start.NepaliDate().AddDays(days - 1); err == nil {
+			enc.line("X-NEPALI-DTEND;VALUE=DATE", endDate.Time().Format("%Y%m%d"))
+		}
+	} else {
+		enc.line("DTSTART", formatUTC(start.GetEnglishTime()))
+		if event.End != nil {
+			duration := event.End.GetEnglishTime().Sub(event.Start.GetEnglishTime())
+			end, err := nepalitime.FromEnglishTime(start.GetEnglishTime().Add(duration))
+			if err == nil {
+				enc.line("DTEND", formatUTC(end.GetEnglishTime()))
+			}
+		}
+		enc.line("X-NEPALI-DTSTART", start.Format("%Y%m%dT%H%M%S"))
+	}
+
+	if len(occurrences) > 1 {
+		dates := make([]string, 0, len(occurrences)-1)
+		for _, occurrence := range occurrences[1:] {
+			if event.AllDay {
+				dates = append(dates, formatDate(occurrence.GetEnglishTime()))
+			} else {
+				dates = append(dates, formatUTC(occurrence.GetEnglishTime()))
+			}
+		}
+		if event.AllDay {
+			enc.line("RDATE;VALUE=DATE", strings.Join(dates, ","))
+		} else {
+			enc.line("RDATE", strings.Join(dates, ","))
+		}
+	}
+	if event.Recurrence != nil {
+		enc.line("X-NEPALI-RRULE", event.Recurrence.String())
+	}
+	if event.RRule != "" {
+		enc.line("RRULE", event.RRule)
+	}
+
+	enc.line("SUMMARY", escapeText(event.Summary))
+	if event.Description != "" {
+		enc.line("DESCRIPTION", escapeText(event.Description))
+	}
+	if event.Location != "" {
+		enc.line("LOCATION", escapeText(event.Location))
+	}
+	enc.line("END", "VEVENT")
+}
+
+// occurrences of the recurring event, limited by MaxOccurrences
+func (c *Calendar) occurrences(event Event) []*nepalitime.NepaliTime {
+	limit := c.MaxOccurrences
+	if limit <= 0 {
+		limit = DefaultMaxOccurrences
+	}
+
+	var occurrences []*nepalitime.NepaliTime
+	for occurrence := range event.Recurrence.All() {
+		occurrences = append(occurrences, occurrence)
+		if len(occurrences) >= limit {
+			break
+		}
+	}
+	return occurrences
+}
+
+// UID of the event, generated from the summary and the start if empty
+func (e Event) uid() string {
+	if e.UID != "" {
+		return e.UID
+	}
+
+	sum := sha1.Sum([]byte(e.Summary + "\x00" + e.Start.GetEnglishTime().UTC().Format(time.RFC3339Nano)))
+	return hex.EncodeToString(sum[:10]) + "@go-nepali"
+}
+
+// encoder writes the content lines, folded at 75 octets
+type encoder struct {
+	buf *bytes.Buffer
+}
+
+const maxLineOctets = 75
+
+func (enc *encoder) line(name, value string) {
+	line := name + ":" + value
+
+	width := 0
+	for len(line) > 0 {
+		_, size := utf8.DecodeRuneInString(line)
+		// folding without breaking the utf-8 sequences
+		if width+size > maxLineOctets {
+			enc.buf.WriteString("\r\n ")
+			width = 1
+		}
+		enc.buf.WriteString(line[:size])
+		width += size
+		line = line[size:]
+	}
+	enc.buf.WriteString("\r\n")
+}
+
+var textEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+
+func escapeText(text string) string {
+	return textEscaper.Replace(text)
+}
+
+func formatUTC(t time.Time) string {
+	return t.UTC().Format("20060102T150405Z")
+}
+
+func formatDate(t time.Time) string {
+	return t.Format("20060102")
+}
@@ -0,0 +1,137 @@
+package ics_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/opensource-nepal/go-nepali/ics"
+	"github.com/opensource-nepal/go-nepali/nepalitime"
+	"github.com/opensource-nepal/go-nepali/recurrence"
+	"github.com/stretchr/testify/assert"
+)
+
+func mustTime(t *testing.T, year, month, day, hour, min int) *nepalitime.NepaliTime {
+	t.Helper()
+
+	nt, err := nepalitime.Date(year, month, day, hour, min, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return nt
+}
+
+func useFakeClock(t *testing.T) {
+	restore := nepalitime.SetClock(nepalitime.NewFakeClock(time.Date(2023, 4, 1, 0, 0, 0, 0, time.UTC)))
+	t.Cleanup(restore)
+}
+
+func TestWriteTimedEvent(t *testing.T) {
+	useFakeClock(t)
+
+	cal := &ics.Calendar{
+		Name: "Office, Kathmandu",
+		Events: []ics.Event{{
+			UID:         "meeting@example.com",
+			Summary:     "Board meeting; review",
+			Description: "agenda\nminutes",
+			Location:    "Kathmandu",
+			Start:       mustTime(t, 2080, 1, 5, 10, 0),
+			End:         mustTime(t, 2080, 1, 5, 11, 30),
+		}},
+	}
+
+	expected := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//opensource-nepal//go-nepali//EN",
+		"CALSCALE:GREGORIAN",
+		`X-WR-CALNAME:Office\, Kathmandu`,
+		"BEGIN:VEVENT",
+		"UID:meeting@example.com",
+		"DTSTAMP:20230401T000000Z",
+		"DTSTART:20230418T041500Z",
+		"DTEND:20230418T054500Z",
+		"X-NEPALI-DTSTART:20800105T100000",
+		`SUMMARY:Board meeting\; review`,
+		`DESCRIPTION:agenda\nminutes`,
+		"LOCATION:Kathmandu",
+		"END:VEVENT",
+		"END:VCALENDAR",
+		"",
+	}, "\r\n")
+	assert.Equal(t, expected, cal.String())
+}
+
+func TestWriteHoliday(t *testing.T) {
+	useFakeClock(t)
+
+	date, _ := nepalitime.NewDate(2080, 1, 1)
+	holiday := ics.NewHoliday("नयाँ वर्ष", date)
+	holiday.UID = "new-year@example.com"
+	cal := &ics.Calendar{Events: []ics.Event{holiday}}
+
+	got := cal.String()
+	assert.Contains(t, got, "\r\nDTSTART;VALUE=DATE:20230414\r\nDTEND;VALUE=DATE:20230415\r\n")
+	assert.Contains(t, got, "\r\nX-NEPALI-DTSTART;VALUE=DATE:20800101\r\nX-NEPALI-DTEND;VALUE=DATE:20800101\r\n")
+	assert.Contains(t, got, "\r\nSUMMARY:नयाँ वर्ष\r\n")
+}
+
+func TestWriteMultiDayEvent(t *testing.T) {
+	useFakeClock(t)
+
+	// Dashain holidays crossing the end of Ashwin 2080 (30 days)
+	event := ics.Event{UID: "dashain@example.com", Summary: "दशैं बिदा", Start: mustTime(t, 2080, 6, 29, 0, 0), End: mustTime(t, 2080, 7, 2, 0, 0), AllDay: true}
+	got := (&ics.Calendar{Events: []ics.Event{event}}).String()
+
+	assert.Contains(t, got, "\r\nDTSTART;VALUE=DATE:20231016\r\nDTEND;VALUE=DATE:20231020\r\n")
+	assert.Contains(t, got, "\r\nX-NEPALI-DTSTART;VALUE=DATE:20800629\r\nX-NEPALI-DTEND;VALUE=DATE:20800702\r\n")
+}
+
+func TestWriteRecurringEvent(t *testing.T) {
+	useFakeClock(t)
+
+	start := mustTime(t, 2080, 1, 1, 0, 0)
+	rule, _ := recurrence.Parse("FREQ=MONTHLY;BYMONTHDAY=-1;COUNT=3", start)
+	event := ics.Event{UID: "closing@example.com", Summary: "Month closing", Start: start, AllDay: true, Recurrence: rule}
+	got := (&ics.Calendar{Events: []ics.Event{event}}).String()
+
+	// the first occurrence is the start
+	assert.Contains(t, got, "\r\nDTSTART;VALUE=DATE:20230514\r\n")
+	assert.Contains(t, got, "\r\nRDATE;VALUE=DATE:20230615,20230716\r\n")
+	assert.Contains(t, got, "\r\nX-NEPALI-RRULE:FREQ=MONTHLY;COUNT=3;BYMONTHDAY=-1\r\n")
+	assert.NotContains(t, got, "\r\nRRULE:")
+}
+
+func TestWriteRecurringEventWithMaxOccurrences(t *testing.T) {
+	useFakeClock(t)
+
+	start := mustTime(t, 2080, 1, 1, 9, 0)
+	rule, _ := recurrence.Parse("FREQ=YEARLY", start)
+	cal := &ics.Calendar{MaxOccurrences: 3, Events: []ics.Event{{UID: "new-year@example.com", Summary: "New year", Start: start, Recurrence: rule}}}
+
+	assert.Contains(t, cal.String(), "\r\nRDATE:20240413T031500Z,20250414T031500Z\r\n")
+}
+
+func TestWriteFoldsLongLines(t *testing.T) {
+	useFakeClock(t)
+
+	event := ics.Event{UID: "long@example.com", Summary: strings.Repeat("नेपाल ", 20), Start: mustTime(t, 2080, 1, 1, 0, 0)}
+	got := (&ics.Calendar{Events: []ics.Event{event}}).String()
+
+	for _, line := range strings.Split(got, "\r\n") {
+		assert.LessOrEqual(t, len(line), 75, line)
+		assert.True(t, strings.ToValidUTF8(line, "?") == line, line)
+	}
+}
+
+func TestGeneratedUID(t *testing.T) {
+	useFakeClock(t)
+
+	event := ics.Event{Summary: "Meeting", Start: mustTime(t, 2080, 1, 1, 10, 0)}
+	first := (&ics.Calendar{Events: []ics.Event{event}}).String()
+	second := (&ics.Calendar{Events: []ics.Event{event}}).String()
+
+	assert.Contains(t, first, "@go-nepali\r\n")
+	assert.Equal(t, first, second)
+}
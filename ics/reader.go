@@ -0,0 +1,226 @@
+package ics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/opensource-nepal/go-nepali/nepalitime"
+	"github.com/opensource-nepal/go-nepali/recurrence"
+)
+
+// Parse reads the iCalendar file and returns the calendar with the events
+// annotated with the NepaliTime of their AD dates.
+//
+// The DTSTART and DTEND in UTC, with TZID or floating (taken as Asia/Kathmandu) are supported.
+// The TZID is looked up in the IANA database, then in the VTIMEZONE components of the file
+// (eg. "Nepal Standard Time" of Outlook), the unknown zones are taken as floating.
+// The recurrence rule in X-NEPALI-RRULE is parsed into Event.Recurrence and RRULE is kept in Event.RRule.
+// Other components (eg. VTODO, and VALARM inside the events) and properties are ignored.
+func Parse(r io.Reader) (*Calendar, error) {
+	lines, err := unfold(r)
+	if err != nil {
+		return nil, err
+	}
+
+	zones := parseTimezones(lines)
+
+	cal := &Calendar{}
+	var (
+		event       *Event
+		nepaliRRule string
+		// names of the components the line is in, eg. [VCALENDAR VEVENT VALARM]
+		components []string
+		// len(components) inside the event, the properties of its nested components (eg. VALARM) are ignored
+		eventDepth int
+	)
+	for number, line := range lines {
+		prop, err := parseProperty(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", number+1, err)
+		}
+
+		switch {
+		case prop.name == "BEGIN":
+			components = append(components, strings.ToUpper(prop.value))
+			if event == nil && strings.EqualFold(prop.value, "VEVENT") {
+				event, nepaliRRule, eventDepth = &Event{}, "", len(components)
+			}
+		case prop.name == "END":
+			component := strings.ToUpper(prop.value)
+			if len(components) == 0 || components[len(components)-1] != component {
+				return nil, fmt.Errorf("line %d: END:%s without BEGIN:%s", number+1, component, component)
+			}
+			depth := len(components)
+			components = components[:depth-1]
+			if event == nil || depth != eventDepth {
+				continue
+			}
+
+			if event.Start == nil {
+				return nil, fmt.Errorf("line %d: event without DTSTART", number+1)
+			}
+			if nepaliRRule != "" {
+				if event.Recurrence, err = recurrence.Parse(nepaliRRule, event.Start); err != nil {
+					return nil, fmt.Errorf("line %d: %w", number+1, err)
+				}
+			}
+			cal.Events = append(cal.Events, *event)
+			event = nil
+		case event != nil:
+			if len(components) != eventDepth {
+				continue
+			}
+			if err := event.setProperty(prop, &nepaliRRule, zones); err != nil {
+				return nil, fmt.Errorf("line %d: %w", number+1, err)
+			}
+		case len(components) == 0 || components[len(components)-1] == "VCALENDAR":
+			switch prop.name {
+			case "PRODID":
+				cal.ProdID = prop.value
+			case "X-WR-CALNAME":
+				cal.Name = unescapeText(prop.value)
+			}
+		}
+	}
+
+	if len(components) > 0 {
+		component := components[len(components)-1]
+		return nil, fmt.Errorf("BEGIN:%s without END:%s", component, component)
+	}
+	return cal, nil
+}
+
+func (e *Event) setProperty(prop property, nepaliRRule *string, zones map[string]*timezone) error {
+	var err error
+	switch prop.name {
+	case "UID":
+		e.UID = prop.value
+	case "SUMMARY":
+		e.Summary = unescapeText(prop.value)
+	case "DESCRIPTION":
+		e.Description = unescapeText(prop.value)
+	case "LOCATION":
+		e.Location = unescapeText(prop.value)
+	case "RRULE":
+		e.RRule = prop.value
+	case "X-NEPALI-RRULE":
+		*nepaliRRule = prop.value
+	case "DTSTART":
+		var allDay bool
+		e.Start, allDay, err = parseDateTime(prop, zones)
+		e.AllDay = allDay
+	case "DTEND":
+		var allDay bool
+		e.End, allDay, err = parseDateTime(prop, zones)
+		if err == nil && allDay {
+			// the last day of an all-day event is inclusive
+			var lastDay nepalitime.NepaliDate
+			lastDay, err = e.End.NepaliDate().AddDays(-1)
+			e.End = lastDay.Time()
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("invalid value in %s: %w", prop.name, err)
+	}
+	return nil
+}
+
+// content line of the iCalendar, eg. DTSTART;TZID=Asia/Kathmandu:20230414T090000
+type property struct {
+	name   string
+	params map[string]string
+	value  string
+}
+
+func parseProperty(line string) (property, error) {
+	// the value starts after the first colon outside of the quotes
+	quoted := false
+	colon := -1
+	for i, char := range line {
+		if char == '"' {
+			quoted = !quoted
+		} else if char == ':' && !quoted {
+			colon = i
+			break
+		}
+	}
+	if colon == -1 {
+		return property{}, fmt.Errorf("invalid content line %q", line)
+	}
+
+	parts := strings.Split(line[:colon], ";")
+	prop := property{name: strings.ToUpper(parts[0]), params: map[string]string{}, value: line[colon+1:]}
+	for _, param := range parts[1:] {
+		key, value, _ := strings.Cut(param, "=")
+		prop.params[strings.ToUpper(key)] = strings.Trim(value, `"`)
+	}
+	return prop, nil
+}
+
+// parses the DATE or DATE-TIME value into NepaliTime,
+// reports whether the value is a DATE
+func parseDateTime(prop property, zones map[string]*timezone) (*nepalitime.NepaliTime, bool, error) {
+	value := prop.value
+	nepaliLoc := nepalitime.GetNepaliLocation()
+
+	if prop.params["VALUE"] == "DATE" || len(value) == len("20060102") {
+		date, err := time.ParseInLocation("20060102", value, nepaliLoc)
+		if err != nil {
+			return nil, false, err
+		}
+		nt, err := nepalitime.FromEnglishTime(date)
+		return nt, true, err
+	}
+
+	loc := nepaliLoc
+	if strings.HasSuffix(value, "Z") {
+		loc, value = time.UTC, strings.TrimSuffix(value, "Z")
+	}
+
+	t, err := time.ParseInLocation("20060102T150405", value, loc)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if tzid := prop.params["TZID"]; tzid != "" && loc != time.UTC {
+		if ianaLoc, err := time.LoadLocation(tzid); err == nil {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), 0, ianaLoc)
+		} else if zone, ok := zones[tzid]; ok {
+			t = zone.localTime(t)
+		}
+		// else the unknown zone is taken as floating
+	}
+
+	nt, err := nepalitime.FromEnglishTime(t)
+	return nt, false, err
+}
+
+// reads the content lines, joining the folded lines
+func unfold(r io.Reader) ([]string, error) {
+	var lines []string
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if line == "" {
+			continue
+		}
+		if (line[0] == ' ' || line[0] == '\t') && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+
+	return lines, scanner.Err()
+}
+
+var textUnescaper = strings.NewReplacer(`\\`, `\`, `\;`, ";", `\,`, ",", `\n`, "\n", `\N`, "\n")
+
+func unescapeText(text string) string {
+	return textUnescaper.Replace(text)
+}
@@ -0,0 +1,148 @@
+package ics_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/opensource-nepal/go-nepali/ics"
+	"github.com/opensource-nepal/go-nepali/nepalitime"
+	"github.com/opensource-nepal/go-nepali/recurrence"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	data := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//Google Inc//Google Calendar 70.9054//EN",
+		"X-WR-CALNAME:Holidays",
+		"BEGIN:VEVENT",
+		"UID:utc@example.com",
+		"DTSTART:20230418T041500Z",
+		"DTEND:20230418T054500Z",
+		`SUMMARY:Board meeting\; review`,
+		"DESCRIPTION:a long desc",
+		" ription",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:tzid@example.com",
+		`DTSTART;TZID="America/New_York":20230418T090000`,
+		"RRULE:FREQ=WEEKLY",
+		"SUMMARY:Standup",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:holiday@example.com",
+		"DTSTART;VALUE=DATE:20231016",
+		"DTEND;VALUE=DATE:20231020",
+		"SUMMARY:दशैं बिदा",
+		"END:VEVENT",
+		"BEGIN:VTODO",
+		"SUMMARY:ignored",
+		"END:VTODO",
+		"END:VCALENDAR",
+	}, "\r\n")
+
+	cal, err := ics.Parse(strings.NewReader(data))
+	if !assert.Nil(t, err, "error should be nil") {
+		return
+	}
+
+	assert.Equal(t, "Holidays", cal.Name)
+	assert.Equal(t, "-//Google Inc//Google Calendar 70.9054//EN", cal.ProdID)
+	if !assert.Len(t, cal.Events, 3) {
+		return
+	}
+
+	meeting := cal.Events[0]
+	assert.Equal(t, "utc@example.com", meeting.UID)
+	assert.Equal(t, "Board meeting; review", meeting.Summary)
+	assert.Equal(t, "a long description", meeting.Description)
+	assert.Equal(t, "2080-01-05 10:00:00", meeting.Start.String())
+	assert.Equal(t, "2080-01-05 11:30:00", meeting.End.String())
+	assert.False(t, meeting.AllDay)
+
+	// 09:00 in New York is 18:45 in Kathmandu
+	standup := cal.Events[1]
+	assert.Equal(t, "2080-01-05 18:45:00", standup.Start.String())
+	assert.Equal(t, "FREQ=WEEKLY", standup.RRule)
+	assert.Nil(t, standup.End)
+
+	holiday := cal.Events[2]
+	assert.True(t, holiday.AllDay)
+	assert.Equal(t, "दशैं बिदा", holiday.Summary)
+	assert.Equal(t, "2080-06-29", holiday.Start.NepaliDate().String())
+	assert.Equal(t, "2080-07-02", holiday.End.NepaliDate().String())
+}
+
+func TestParseRoundTrip(t *testing.T) {
+	useFakeClock(t)
+
+	start := mustTime(t, 2080, 1, 31, 0, 0)
+	rule, _ := recurrence.Parse("FREQ=MONTHLY;BYMONTHDAY=-1;COUNT=3", start)
+	date, _ := nepalitime.NewDate(2080, 1, 1)
+
+	cal := &ics.Calendar{Name: "Office", Events: []ics.Event{
+		ics.NewHoliday("नयाँ वर्ष", date),
+		{UID: "closing@example.com", Summary: "Month closing, accounts", Start: start, AllDay: true, Recurrence: rule},
+		{UID: "meeting@example.com", Summary: "Meeting", Start: mustTime(t, 2080, 1, 5, 10, 0), End: mustTime(t, 2080, 1, 5, 11, 30)},
+	}}
+
+	parsed, err := ics.Parse(strings.NewReader(cal.String()))
+	if !assert.Nil(t, err, "error should be nil") || !assert.Len(t, parsed.Events, 3) {
+		return
+	}
+
+	assert.Equal(t, "Office", parsed.Name)
+	assert.Equal(t, "नयाँ वर्ष", parsed.Events[0].Summary)
+	assert.Equal(t, "2080-01-01 00:00:00", parsed.Events[0].Start.String())
+	assert.Equal(t, "Month closing, accounts", parsed.Events[1].Summary)
+	if assert.NotNil(t, parsed.Events[1].Recurrence) {
+		assert.Equal(t, rule.String(), parsed.Events[1].Recurrence.String())
+	}
+	assert.Equal(t, "2080-01-05 11:30:00", parsed.Events[2].End.String())
+
+	// writing the parsed calendar again
+	assert.Equal(t, cal.String(), parsed.String())
+}
+
+func TestParseWithInvalidData(t *testing.T) {
+	for _, data := range []string{
+		"BEGIN:VEVENT\r\nDTSTART:20230418T041500Z\r\n",
+		"END:VEVENT\r\n",
+		"BEGIN:VEVENT\r\nSUMMARY:no start\r\nEND:VEVENT\r\n",
+		"BEGIN:VEVENT\r\nDTSTART:2023-04-18\r\nEND:VEVENT\r\n",
+		"BEGIN:VEVENT\r\nDTSTART:20230418T041500Z\r\nEND:VALARM\r\nEND:VEVENT\r\n",
+		"BEGIN:VEVENT\r\nDTSTART:20230418T041500Z\r\nBEGIN:VALARM\r\nEND:VEVENT\r\n",
+		"BEGIN:VEVENT\r\nDTSTART:20230418T041500Z\r\nX-NEPALI-RRULE:FREQ=HOURLY\r\nEND:VEVENT\r\n",
+		"no colon\r\n",
+	} {
+		_, err := ics.Parse(strings.NewReader(data))
+		assert.NotNil(t, err, data)
+	}
+}
+
+func TestParseIgnoresNestedComponents(t *testing.T) {
+	data := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"BEGIN:VEVENT",
+		"UID:alarm@example.com",
+		"DTSTART:20230418T041500Z",
+		"DESCRIPTION:Agenda",
+		"BEGIN:VALARM",
+		"ACTION:DISPLAY",
+		"DESCRIPTION:Reminder",
+		"TRIGGER:-PT15M",
+		"END:VALARM",
+		"SUMMARY:Board meeting",
+		"END:VEVENT",
+		"END:VCALENDAR",
+	}, "\r\n")
+
+	cal, err := ics.Parse(strings.NewReader(data))
+	if !assert.Nil(t, err, "error should be nil") || !assert.Len(t, cal.Events, 1) {
+		return
+	}
+
+	assert.Equal(t, "Agenda", cal.Events[0].Description)
+	assert.Equal(t, "Board meeting", cal.Events[0].Summary)
+}
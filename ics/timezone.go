@@ -0,0 +1,252 @@
+package ics
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// zone defined by a VTIMEZONE component of the file,
+// eg. "Nepal Standard Time" or "Eastern Standard Time" of Outlook
+type timezone struct {
+	id          string // TZID
+	observances []observance
+}
+
+// STANDARD or DAYLIGHT component of a VTIMEZONE.
+// The times are the local times as UTC, the zone is known only after choosing the observance.
+type observance struct {
+	name   string      // TZNAME
+	offset int         // TZOFFSETTO in seconds
+	start  time.Time   // DTSTART, the first onset
+	dates  []time.Time // RDATE, the other onsets
+
+	// yearly RRULE, eg. BYMONTH=3;BYDAY=2SU is the second sunday of March
+	month   time.Month
+	week    int // 1 to 5 or -5 to -1 from the end, 0 without RRULE
+	weekday time.Weekday
+	until   time.Time
+}
+
+var icsWeekdays = [...]string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+
+// parses the VTIMEZONE components by their TZID,
+// the zones with unsupported rules are skipped so their times are taken as floating
+func parseTimezones(lines []string) map[string]*timezone {
+	zones := map[string]*timezone{}
+	var (
+		tzid  string
+		zone  *timezone
+		obs   *observance
+		valid bool
+	)
+	for _, line := range lines {
+		prop, err := parseProperty(line)
+		if err != nil {
+			// reported by Parse()
+			continue
+		}
+
+		component := strings.ToUpper(prop.value)
+		isObservance := component == "STANDARD" || component == "DAYLIGHT"
+		switch {
+		case prop.name == "BEGIN" && component == "VTIMEZONE":
+			tzid, zone, obs, valid = "", &timezone{}, nil, true
+		case zone == nil:
+			continue
+		case prop.name == "END" && component == "VTIMEZONE":
+			if tzid != "" && valid && len(zone.observances) > 0 {
+				zone.id = tzid
+				zones[tzid] = zone
+			}
+			zone = nil
+		case prop.name == "BEGIN" && isObservance:
+			obs = &observance{}
+		case prop.name == "END" && isObservance && obs != nil:
+			if obs.start.IsZero() {
+				valid = false
+			}
+			zone.observances = append(zone.observances, *obs)
+			obs = nil
+		case obs != nil:
+			if err := obs.setProperty(prop); err != nil {
+				valid = false
+			}
+		case prop.name == "TZID":
+			tzid = prop.value
+		}
+	}
+	return zones
+}
+
+func (obs *observance) setProperty(prop property) error {
+	var err error
+	switch prop.name {
+	case "TZNAME":
+		obs.name = prop.value
+	case "TZOFFSETTO":
+		obs.offset, err = parseOffset(prop.value)
+	case "DTSTART":
+		obs.start, err = parseLocalTime(prop.value)
+	case "RDATE":
+		for _, value := range strings.Split(prop.value, ",") {
+			var date time.Time
+			if date, err = parseLocalTime(value); err != nil {
+				break
+			}
+			obs.dates = append(obs.dates, date)
+		}
+	case "RRULE":
+		err = obs.setRule(prop.value)
+	}
+	return err
+}
+
+// parses the yearly rule of the transitions, eg. FREQ=YEARLY;BYMONTH=10;BYDAY=-1SU
+func (obs *observance) setRule(rrule string) error {
+	for _, part := range strings.Split(rrule, ";") {
+		key, value, _ := strings.Cut(part, "=")
+		switch strings.ToUpper(key) {
+		case "FREQ":
+			if !strings.EqualFold(value, "YEARLY") {
+				return fmt.Errorf("unsupported frequency %q", value)
+			}
+		case "BYMONTH":
+			month, err := strconv.Atoi(value)
+			if err != nil || month < 1 || month > 12 {
+				return fmt.Errorf("invalid month %q", value)
+			}
+			obs.month = time.Month(month)
+		case "BYDAY":
+			value = strings.ToUpper(value)
+			if len(value) < 3 {
+				return fmt.Errorf("unsupported weekday %q", value)
+			}
+			weekday := slices.Index(icsWeekdays[:], value[len(value)-2:])
+			week, err := strconv.Atoi(value[:len(value)-2])
+			if weekday == -1 || err != nil || week == 0 || week < -5 || week > 5 {
+				return fmt.Errorf("unsupported weekday %q", value)
+			}
+			obs.week, obs.weekday = week, time.Weekday(weekday)
+		case "UNTIL":
+			until, err := parseLocalTime(strings.TrimSuffix(value, "Z"))
+			if err != nil {
+				return err
+			}
+			obs.until = until
+		default:
+			return fmt.Errorf("unsupported rule %q", part)
+		}
+	}
+
+	if obs.month == 0 || obs.week == 0 {
+		return fmt.Errorf("unsupported rule %q", rrule)
+	}
+	return nil
+}
+
+// returns the local time in the zone of the observance in effect at that time
+func (tz *timezone) localTime(local time.Time) time.Time {
+	var (
+		current *observance
+		latest  time.Time
+	)
+	for i := range tz.observances {
+		obs := &tz.observances[i]
+		if onset, ok := obs.lastOnset(local); ok && (current == nil || onset.After(latest)) {
+			current, latest = obs, onset
+		}
+	}
+	if current == nil {
+		// before all the onsets, the earliest observance is used
+		current = &tz.observances[0]
+		for i := range tz.observances {
+			if tz.observances[i].start.Before(current.start) {
+				current = &tz.observances[i]
+			}
+		}
+	}
+
+	name := current.name
+	if name == "" {
+		name = tz.id
+	}
+	loc := time.FixedZone(name, current.offset)
+	return time.Date(local.Year(), local.Month(), local.Day(), local.Hour(), local.Minute(), local.Second(), local.Nanosecond(), loc)
+}
+
+// latest onset of the observance at or before the local time
+func (obs *observance) lastOnset(local time.Time) (time.Time, bool) {
+	var last time.Time
+	found := false
+	consider := func(onset time.Time) {
+		if !onset.After(local) && (!found || onset.After(last)) {
+			last, found = onset, true
+		}
+	}
+
+	consider(obs.start)
+	for _, date := range obs.dates {
+		consider(date)
+	}
+	if obs.week != 0 {
+		for _, year := range []int{local.Year() - 1, local.Year()} {
+			onset, ok := nthWeekday(year, obs.month, obs.week, obs.weekday, obs.start)
+			if !ok || onset.Before(obs.start) || (!obs.until.IsZero() && onset.After(obs.until)) {
+				continue
+			}
+			consider(onset)
+		}
+	}
+	return last, found
+}
+
+// the nth weekday of the month at the clock time of clock, eg. 2nd or last (-1) sunday,
+// false if the month doesn't have the nth weekday
+func nthWeekday(year int, month time.Month, n int, weekday time.Weekday, clock time.Time) (time.Time, bool) {
+	hour, minute, second := clock.Clock()
+
+	var date time.Time
+	if n > 0 {
+		first := time.Date(year, month, 1, hour, minute, second, 0, time.UTC)
+		days := (int(weekday) - int(first.Weekday()) + 7) % 7
+		date = first.AddDate(0, 0, days+(n-1)*7)
+	} else {
+		last := time.Date(year, month+1, 0, hour, minute, second, 0, time.UTC)
+		days := (int(last.Weekday()) - int(weekday) + 7) % 7
+		date = last.AddDate(0, 0, -days+(n+1)*7)
+	}
+	return date, date.Month() == month
+}
+
+// parses the local DATE-TIME (or DATE) as UTC, eg. 19700101T000000
+func parseLocalTime(value string) (time.Time, error) {
+	if len(value) == len("20060102") {
+		return time.Parse("20060102", value)
+	}
+	return time.Parse("20060102T150405", value)
+}
+
+// parses the UTC offset into seconds, eg. "+0545", "-0500" or "+053045"
+func parseOffset(value string) (int, error) {
+	if len(value) != 5 && len(value) != 7 || (value[0] != '+' && value[0] != '-') {
+		return 0, errors.New("invalid UTC offset " + strconv.Quote(value))
+	}
+
+	digits := value[1:] + "00"
+	hours, err1 := strconv.Atoi(digits[0:2])
+	minutes, err2 := strconv.Atoi(digits[2:4])
+	seconds, err3 := strconv.Atoi(digits[4:6])
+	if err1 != nil || err2 != nil || err3 != nil {
+		return 0, errors.New("invalid UTC offset " + strconv.Quote(value))
+	}
+
+	offset := hours*3600 + minutes*60 + seconds
+	if value[0] == '-' {
+		offset = -offset
+	}
+	return offset, nil
+}
@@ -0,0 +1,108 @@
+package ics_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/opensource-nepal/go-nepali/ics"
+	"github.com/stretchr/testify/assert"
+)
+
+// VTIMEZONE components as written by Outlook
+var outlookTimezones = []string{
+	"BEGIN:VTIMEZONE",
+	"TZID:Nepal Standard Time",
+	"BEGIN:STANDARD",
+	"DTSTART:16010101T000000",
+	"TZOFFSETFROM:+0545",
+	"TZOFFSETTO:+0545",
+	"END:STANDARD",
+	"END:VTIMEZONE",
+	"BEGIN:VTIMEZONE",
+	"TZID:Eastern Standard Time",
+	"BEGIN:STANDARD",
+	"DTSTART:16010101T020000",
+	"TZOFFSETFROM:-0400",
+	"TZOFFSETTO:-0500",
+	"RRULE:FREQ=YEARLY;BYDAY=1SU;BYMONTH=11",
+	"END:STANDARD",
+	"BEGIN:DAYLIGHT",
+	"DTSTART:16010101T020000",
+	"TZOFFSETFROM:-0500",
+	"TZOFFSETTO:-0400",
+	"RRULE:FREQ=YEARLY;BYDAY=2SU;BYMONTH=3",
+	"END:DAYLIGHT",
+	"END:VTIMEZONE",
+}
+
+func parseEventStarts(t *testing.T, starts ...string) []string {
+	t.Helper()
+
+	lines := []string{"BEGIN:VCALENDAR"}
+	for _, start := range starts {
+		lines = append(lines, "BEGIN:VEVENT", start, "END:VEVENT")
+	}
+	// the VTIMEZONE can be after the events that use it
+	lines = append(lines, outlookTimezones...)
+	lines = append(lines, "END:VCALENDAR")
+
+	cal, err := ics.Parse(strings.NewReader(strings.Join(lines, "\r\n")))
+	if !assert.Nil(t, err, "error should be nil") {
+		return nil
+	}
+
+	var parsed []string
+	for _, event := range cal.Events {
+		parsed = append(parsed, event.Start.String())
+	}
+	return parsed
+}
+
+func TestParseWithTimezoneOfFile(t *testing.T) {
+	assert.Equal(t, []string{
+		"2080-01-05 09:00:00",
+		// 09:00 EDT is 18:45 in Kathmandu
+		"2080-01-05 18:45:00",
+		// 09:00 EST is 19:45 in Kathmandu
+		"2079-10-04 19:45:00",
+		// after the first sunday of November
+		"2080-07-20 19:45:00",
+		// after the second sunday of March
+		"2079-11-29 18:45:00",
+	}, parseEventStarts(t,
+		"DTSTART;TZID=Nepal Standard Time:20230418T090000",
+		`DTSTART;TZID="Eastern Standard Time":20230418T090000`,
+		"DTSTART;TZID=Eastern Standard Time:20230118T090000",
+		"DTSTART;TZID=Eastern Standard Time:20231106T090000",
+		"DTSTART;TZID=Eastern Standard Time:20230313T090000",
+	))
+}
+
+func TestParseWithUnknownTimezoneIsFloating(t *testing.T) {
+	assert.Equal(t, []string{
+		"2080-01-05 09:00:00",
+	}, parseEventStarts(t, "DTSTART;TZID=Nowhere/City:20230418T090000"))
+}
+
+func TestParseWithUnsupportedTimezoneRuleIsFloating(t *testing.T) {
+	data := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"BEGIN:VTIMEZONE",
+		"TZID:Custom",
+		"BEGIN:STANDARD",
+		"DTSTART:19700101T000000",
+		"TZOFFSETTO:-0500",
+		"RRULE:FREQ=MONTHLY;BYMONTHDAY=1",
+		"END:STANDARD",
+		"END:VTIMEZONE",
+		"BEGIN:VEVENT",
+		"DTSTART;TZID=Custom:20230418T090000",
+		"END:VEVENT",
+		"END:VCALENDAR",
+	}, "\r\n")
+
+	cal, err := ics.Parse(strings.NewReader(data))
+	if assert.Nil(t, err, "error should be nil") && assert.Len(t, cal.Events, 1) {
+		assert.Equal(t, "2080-01-05 09:00:00", cal.Events[0].Start.String())
+	}
+}
@@ -0,0 +1,115 @@
+package money
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// MarshalJSON returns the amount as the JSON string of the decimal rupees, eg. "1234.50".
+// The string keeps the exact amount in any JSON parser, unlike the floating point numbers.
+func (m NPR) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + m.Decimal() + `"`), nil
+}
+
+// UnmarshalJSON parses the amount from a JSON string in any format of Parse(), eg. "Rs. 1,234.50",
+// or from a JSON number of rupees, eg. 1234.5. The number is parsed from its text without floating point.
+func (m *NPR) UnmarshalJSON(data []byte) error {
+	text := string(data)
+	if text == "null" {
+		return nil
+	}
+
+	if strings.HasPrefix(text, `"`) {
+		var str string
+		if err := json.Unmarshal(data, &str); err != nil {
+			return err
+		}
+		parsed, err := Parse(str)
+		if err != nil {
+			return err
+		}
+		*m = parsed
+		return nil
+	}
+
+	parsed, err := parseDecimal(text)
+	if err != nil {
+		return fmt.Errorf("%w %s", ErrInvalidAmount, text)
+	}
+	*m = parsed
+	return nil
+}
+
+// Value returns the amount in paisa (int64) for the database, eg. for a BIGINT column.
+// It implements driver.Valuer. Use NPRDecimal for the decimal columns.
+func (m NPR) Value() (driver.Value, error) {
+	return int64(m), nil
+}
+
+// Scan reads the amount in paisa from an integer column, eg. BIGINT. It implements sql.Scanner.
+// NULL is scanned as zero.
+//
+// Only the integers are accepted, the same unit as Value(). The text and the floats are rejected
+// instead of guessing their unit, use NPRDecimal for the decimal columns, eg. NUMERIC(15, 2).
+func (m *NPR) Scan(src any) error {
+	switch src := src.(type) {
+	case nil:
+		*m = 0
+	case int64:
+		*m = NPR(src)
+	default:
+		return fmt.Errorf("money: cannot scan %T into NPR, it's stored in integer paisa (use NPRDecimal for the decimal columns)", src)
+	}
+	return nil
+}
+
+// NPRDecimal is an amount stored in the database as the decimal rupees, eg. in a NUMERIC(15, 2) column.
+// It's the conversion of NPR for the decimal columns, eg.
+//
+//	db.Exec("INSERT INTO invoices (total) VALUES ($1)", money.NPRDecimal(total))
+//	row.Scan((*money.NPRDecimal)(&total))
+type NPRDecimal NPR
+
+// Value returns the decimal rupees for the database, eg. "1234.50".
+// It implements driver.Valuer.
+func (d NPRDecimal) Value() (driver.Value, error) {
+	return NPR(d).Decimal(), nil
+}
+
+// Scan reads the amount in rupees from a decimal column. It implements sql.Scanner.
+// NULL is scanned as zero.
+//
+// The text and the integers are the rupees, same as Parse(), eg. "1234.50", "1234" and 1234 (eg. SQLite NUMERIC).
+// The floats are accepted only with at most 2 decimal places, eg. 1234.5,
+// else ErrInvalidAmount is returned instead of rounding.
+func (d *NPRDecimal) Scan(src any) error {
+	if text, ok := src.([]byte); ok {
+		src = string(text)
+	}
+
+	var (
+		amount NPR
+		err    error
+	)
+	switch src := src.(type) {
+	case nil:
+	case int64:
+		amount, err = NPR(src).Mul(int64(Rupee))
+	case float64:
+		// the shortest decimal of the float, eg. 0.30000000000000004 for 0.1 + 0.2
+		amount, err = parseDecimal(strconv.FormatFloat(src, 'f', -1, 64))
+	case string:
+		amount, err = parseDecimal(src)
+	default:
+		return fmt.Errorf("money: cannot scan %T into NPRDecimal", src)
+	}
+	if err != nil {
+		return fmt.Errorf("%w %v: %w", ErrInvalidAmount, src, err)
+	}
+
+	*d = NPRDecimal(amount)
+	return nil
+}
@@ -0,0 +1,160 @@
+package money_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/opensource-nepal/go-nepali/money"
+	"github.com/stretchr/testify/assert"
+)
+
+type invoice struct {
+	Total    money.NPR  `json:"total"`
+	Discount *money.NPR `json:"discount"`
+}
+
+func TestMarshalJSON(t *testing.T) {
+	data, err := json.Marshal(invoice{Total: 12345650})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"total": "123456.50", "discount": null}`, string(data))
+
+	data, err = json.Marshal(money.NPR(-5))
+	assert.NoError(t, err)
+	assert.Equal(t, `"-0.05"`, string(data))
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	testCases := []struct {
+		data string
+		want money.NPR
+	}{
+		{`"123456.50"`, 12345650},
+		{`"Rs. 1,23,456.50"`, 12345650},
+		{`"रु. १,२३,४५६.५०"`, 12345650},
+		{`123456.5`, 12345650},
+		{`-10`, -1000},
+		// larger than the float64 precision
+		{`92233720368547758.07`, 9223372036854775807},
+	}
+
+	for _, tc := range testCases {
+		var got money.NPR
+		assert.NoError(t, json.Unmarshal([]byte(tc.data), &got), tc.data)
+		assert.Equal(t, tc.want, got, tc.data)
+	}
+
+	var inv invoice
+	assert.NoError(t, json.Unmarshal([]byte(`{"total": "Rs. 500", "discount": null}`), &inv))
+	assert.Equal(t, invoice{Total: money.Rupees(500)}, inv)
+}
+
+func TestUnmarshalJSONInvalid(t *testing.T) {
+	for _, data := range []string{`"Rs. five"`, `1.234`, `1e3`, `true`, `{}`} {
+		var got money.NPR
+		assert.Error(t, json.Unmarshal([]byte(data), &got), data)
+	}
+}
+
+func TestValue(t *testing.T) {
+	var valuer driver.Valuer = money.NPR(123450)
+
+	value, err := valuer.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(123450), value)
+}
+
+func TestScan(t *testing.T) {
+	testCases := []struct {
+		src  any
+		want money.NPR
+	}{
+		{int64(123450), 123450},
+		{int64(-5), -5},
+		{nil, 0},
+	}
+
+	for _, tc := range testCases {
+		got := money.NPR(1)
+		var scanner sql.Scanner = &got
+		assert.NoError(t, scanner.Scan(tc.src), "%v", tc.src)
+		assert.Equal(t, tc.want, got, "%v", tc.src)
+	}
+}
+
+func TestScanInvalid(t *testing.T) {
+	// the unit of the text and the floats is ambiguous
+	for _, src := range []any{"123450", []byte("123450"), "1234.50", 1234.5, true} {
+		got := money.NPR(1)
+		err := got.Scan(src)
+		assert.ErrorContains(t, err, "money: cannot scan", "%v", src)
+		assert.Equal(t, money.NPR(1), got)
+	}
+	assert.EqualError(t, new(money.NPR).Scan(true), "money: cannot scan bool into NPR, it's stored in integer paisa (use NPRDecimal for the decimal columns)")
+}
+
+func TestValueScanRoundTrip(t *testing.T) {
+	for _, m := range []money.NPR{0, 123450, -5, math.MaxInt64, math.MinInt64} {
+		value, err := m.Value()
+		assert.NoError(t, err)
+
+		var got money.NPR
+		assert.NoError(t, got.Scan(value))
+		assert.Equal(t, m, got)
+
+		value, err = money.NPRDecimal(m).Value()
+		assert.NoError(t, err)
+
+		got = 0
+		assert.NoError(t, (*money.NPRDecimal)(&got).Scan(value))
+		assert.Equal(t, m, got)
+	}
+}
+
+func TestDecimalValue(t *testing.T) {
+	var valuer driver.Valuer = money.NPRDecimal(123450)
+
+	value, err := valuer.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "1234.50", value)
+}
+
+func TestDecimalScan(t *testing.T) {
+	testCases := []struct {
+		src  any
+		want money.NPR
+	}{
+		{"1234.50", 123450},
+		{[]byte("1234.50"), 123450},
+		{"-0.05", -5},
+		// the whole rupees, same as Parse()
+		{"1234", 123400},
+		{[]byte("1234"), 123400},
+		{int64(1234), 123400},
+		{1234.5, 123450},
+		{float64(1234), 123400},
+		{nil, 0},
+	}
+
+	for _, tc := range testCases {
+		got := money.NPR(1)
+		var scanner sql.Scanner = (*money.NPRDecimal)(&got)
+		assert.NoError(t, scanner.Scan(tc.src), "%v", tc.src)
+		assert.Equal(t, tc.want, got, "%v", tc.src)
+	}
+}
+
+func TestDecimalScanInvalid(t *testing.T) {
+	a, b := 0.1, 0.2
+
+	for _, src := range []any{"abc", "1.234", []byte("1.234"), a + b, 1e30, int64(math.MaxInt64)} {
+		var got money.NPRDecimal
+		assert.ErrorIs(t, got.Scan(src), money.ErrInvalidAmount, "%v", src)
+	}
+
+	var got money.NPRDecimal
+	assert.ErrorIs(t, got.Scan(int64(math.MaxInt64)), money.ErrOverflow)
+	assert.EqualError(t, got.Scan(true), "money: cannot scan bool into NPRDecimal")
+}
@@ -0,0 +1,127 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/opensource-nepal/go-nepali/nepalinumber"
+)
+
+// ErrInvalidAmount is returned when the string is not an amount of rupees
+var ErrInvalidAmount = errors.New("invalid amount")
+
+// symbols of the rupees accepted while parsing, the first ones are used to format
+var (
+	englishSymbols = []string{"Rs.", "Rs", "NPR", "रु.", "रु", "रू."}
+	nepaliSymbols  = []string{"रु.", "रु", "रू.", "रू", "Rs.", "Rs", "NPR"}
+)
+
+// String returns the amount in english, eg. "Rs. 1,23,456.00".
+func (m NPR) String() string {
+	return m.Format(nepalinumber.English)
+}
+
+// Format returns the amount with the symbol, grouped in lakh and crore with 2 decimal places,
+// eg. "Rs. 1,23,456.00" (English), "रु. १,२३,४५६.००" (Nepali).
+// The negative amounts are like "Rs. -1,234.50".
+func (m NPR) Format(language nepalinumber.Language) string {
+	if language == nepalinumber.Nepali {
+		return nepaliSymbols[0] + " " + nepalinumber.ToDevanagari(m.Amount())
+	}
+	return englishSymbols[0] + " " + m.Amount()
+}
+
+// Amount returns the amount without the symbol, grouped in lakh and crore, eg. "1,23,456.00".
+func (m NPR) Amount() string {
+	sign := ""
+	if m < 0 {
+		sign = "-"
+	}
+	paisa := absPaisa(m)
+	return fmt.Sprintf("%s%s.%02d", sign, nepalinumber.FormatUint(paisa/100), paisa%100)
+}
+
+// Decimal returns the plain decimal amount of rupees, eg. "123456.00".
+// It is the format used in JSON.
+func (m NPR) Decimal() string {
+	sign := ""
+	if m < 0 {
+		sign = "-"
+	}
+	paisa := absPaisa(m)
+	return fmt.Sprintf("%s%d.%02d", sign, paisa/100, paisa%100)
+}
+
+// Words returns the amount in words, eg. "एक लाख पच्चीस हजार रुपैयाँ पचास पैसा".
+// See nepalinumber.RupeesToWords().
+func (m NPR) Words(language nepalinumber.Language) string {
+	return nepalinumber.RupeesToWords(int64(m), language)
+}
+
+func absPaisa(m NPR) uint64 {
+	if m < 0 {
+		return uint64(-(m + 1)) + 1
+	}
+	return uint64(m)
+}
+
+// Parse parses the amount of rupees, eg. "Rs. 1,23,456.50", "रु. १,२३,४५६.५०", "NPR 1234.5" or "1,234".
+//
+// The symbol is optional, the digits can be ascii or devanagari and the rupees can be grouped in lakh and crore.
+// The amount can have at most 2 decimal places, it isn't rounded.
+// The negative amounts can have the sign before or after the symbol, eg. "-Rs. 50" or "Rs. -50".
+func Parse(str string) (NPR, error) {
+	amount := strings.TrimSpace(str)
+
+	negative := false
+	if rest, ok := strings.CutPrefix(amount, "-"); ok {
+		negative, amount = true, strings.TrimSpace(rest)
+	}
+	for _, symbol := range nepaliSymbols {
+		if rest, ok := strings.CutPrefix(amount, symbol); ok {
+			amount = strings.TrimSpace(rest)
+			break
+		}
+	}
+	if negative && strings.HasPrefix(amount, "-") {
+		return 0, fmt.Errorf("%w %q", ErrInvalidAmount, str)
+	}
+
+	m, err := parseDecimal(amount)
+	if err != nil {
+		return 0, fmt.Errorf("%w %q", ErrInvalidAmount, str)
+	}
+	if negative {
+		m = -m
+	}
+	return m, nil
+}
+
+// MustParse is like Parse() but panics if the amount is invalid.
+// It's meant for the constants, eg. money.MustParse("Rs. 500").
+func MustParse(str string) NPR {
+	m, err := Parse(str)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// parses the decimal amount of rupees in the nepali style into paisa
+func parseDecimal(str string) (NPR, error) {
+	number, err := nepalinumber.Normalize(str)
+	if err != nil {
+		return 0, err
+	}
+	rupees, fraction, _ := strings.Cut(number, ".")
+	if len(fraction) > 2 {
+		return 0, errors.New("more than 2 decimal places")
+	}
+
+	paisa, err := nepalinumber.ParseBigInt(rupees + (fraction + "00")[:2])
+	if err != nil {
+		return 0, err
+	}
+	return fromBig(paisa)
+}
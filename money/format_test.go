@@ -0,0 +1,111 @@
+package money_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/opensource-nepal/go-nepali/money"
+	"github.com/opensource-nepal/go-nepali/nepalinumber"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormat(t *testing.T) {
+	testCases := []struct {
+		m       money.NPR
+		english string
+		nepali  string
+	}{
+		{12345600, "Rs. 1,23,456.00", "रु. १,२३,४५६.००"},
+		{12345650, "Rs. 1,23,456.50", "रु. १,२३,४५६.५०"},
+		{5, "Rs. 0.05", "रु. ०.०५"},
+		{0, "Rs. 0.00", "रु. ०.००"},
+		{-50, "Rs. -0.50", "रु. -०.५०"},
+		{-123450, "Rs. -1,234.50", "रु. -१,२३४.५०"},
+	}
+
+	for _, tc := range testCases {
+		assert.Equal(t, tc.english, tc.m.Format(nepalinumber.English))
+		assert.Equal(t, tc.english, tc.m.String())
+		assert.Equal(t, tc.nepali, tc.m.Format(nepalinumber.Nepali))
+	}
+}
+
+func TestFormatLimits(t *testing.T) {
+	assert.Equal(t, "Rs. 92,23,37,20,36,85,47,758.07", money.NPR(math.MaxInt64).String())
+	assert.Equal(t, "Rs. -92,23,37,20,36,85,47,758.08", money.NPR(math.MinInt64).String())
+	assert.Equal(t, "-92233720368547758.08", money.NPR(math.MinInt64).Decimal())
+}
+
+func TestAmountAndDecimal(t *testing.T) {
+	m := money.NPR(12345650)
+	assert.Equal(t, "1,23,456.50", m.Amount())
+	assert.Equal(t, "123456.50", m.Decimal())
+	assert.Equal(t, "-0.05", money.NPR(-5).Decimal())
+}
+
+func TestWords(t *testing.T) {
+	m := money.NPR(12530050)
+	assert.Equal(t, "एक लाख पच्चीस हजार तीन सय रुपैयाँ पचास पैसा", m.Words(nepalinumber.Nepali))
+	assert.Equal(t, "one lakh twenty-five thousand three hundred rupees and fifty paisa", m.Words(nepalinumber.English))
+}
+
+func TestParse(t *testing.T) {
+	testCases := []struct {
+		str  string
+		want money.NPR
+	}{
+		{"Rs. 1,23,456.00", 12345600},
+		{"रु. १,२३,४५६.००", 12345600},
+		{"Rs 1,23,456.5", 12345650},
+		{"Rs.1234", 123400},
+		{"NPR 1,234.05", 123405},
+		{"रु १२३४", 123400},
+		{"रू. ५०", 5000},
+		{"1,234.50", 123450},
+		{"  0.5 ", 50},
+		{"Rs. -1,234.50", -123450},
+		{"-Rs. 1,234.50", -123450},
+		{"-रु. ०.०५", -5},
+		{"Rs. 92,23,37,20,36,85,47,758.07", math.MaxInt64},
+	}
+
+	for _, tc := range testCases {
+		got, err := money.Parse(tc.str)
+		assert.NoError(t, err, tc.str)
+		assert.Equal(t, tc.want, got, tc.str)
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	testCases := []string{
+		"",
+		"Rs.",
+		"Rs. abc",
+		"Rs. 1.234",   // more than 2 decimal places
+		"Rs. 123,456", // misplaced comma
+		"-Rs. -5",
+		"USD 5",
+		"Rs. 92,23,37,20,36,85,47,758.08", // overflow
+		"5 Rs.",
+	}
+
+	for _, str := range testCases {
+		_, err := money.Parse(str)
+		assert.ErrorIs(t, err, money.ErrInvalidAmount, str)
+	}
+}
+
+func TestFormatParseRoundTrip(t *testing.T) {
+	for _, m := range []money.NPR{0, 1, -1, 99, 100, 123456789, -987654321, math.MaxInt64, math.MinInt64} {
+		for _, language := range []nepalinumber.Language{nepalinumber.English, nepalinumber.Nepali} {
+			got, err := money.Parse(m.Format(language))
+			assert.NoError(t, err, m.Format(language))
+			assert.Equal(t, m, got)
+		}
+	}
+}
+
+func TestMustParse(t *testing.T) {
+	assert.Equal(t, money.Rupees(500), money.MustParse("Rs. 500"))
+	assert.Panics(t, func() { money.MustParse("Rs. five") })
+}
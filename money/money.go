@@ -0,0 +1,229 @@
+// Package money
+// This package contains the NPR type, the amount of nepali rupees in integer paisa (1/100 rupee),
+// with the arithmetic, the rounding modes and the formatting in lakh and crore, eg. "Rs. 1,23,456.00" or "रु. १,२३,४५६.००".
+//
+// USAGE:
+//
+//	price := money.Rupees(1500)                        // Rs. 1,500.00
+//	vat, err := price.MulFrac(13, 100, money.HalfUp)   // Rs. 195.00
+//	total := price + vat                               // the operators work on the paisa
+//	total.Format(nepalinumber.Nepali)                  // रु. १,६९५.००
+//	amount, err := money.Parse("रु. १,२३,४५६.५०")      // 12345650 paisa
+package money
+
+import (
+	"errors"
+	"math/big"
+	"strconv"
+)
+
+// NPR is an amount of nepali rupees in paisa (1/100 rupee).
+//
+// The amounts can be added, subtracted and multiplied by integers with the operators,
+// the methods Add(), Sub(), Mul(), Div() and MulFrac() also check for overflow.
+type NPR int64
+
+const (
+	Paisa NPR = 1
+	Rupee NPR = 100
+)
+
+// ErrOverflow is returned when the result of the arithmetic doesn't fit in NPR
+var ErrOverflow = errors.New("amount overflows")
+
+// Rupees returns the amount of the whole rupees.
+// Like the conversion NPR(paisa), it doesn't check for overflow.
+func Rupees(rupees int64) NPR {
+	return NPR(rupees) * Rupee
+}
+
+// Paisa returns the amount in paisa.
+func (m NPR) Paisa() int64 {
+	return int64(m)
+}
+
+// Split returns the whole rupees and the remaining paisa of the amount,
+// both negative for the negative amounts.
+//
+// eg. Rs. 1,234.50 => 1234, 50
+func (m NPR) Split() (rupees, paisa int64) {
+	return int64(m / Rupee), int64(m % Rupee)
+}
+
+// IsZero reports whether the amount is zero.
+func (m NPR) IsZero() bool {
+	return m == 0
+}
+
+// Sign returns -1, 0 or +1 for the negative, zero and positive amounts.
+func (m NPR) Sign() int {
+	switch {
+	case m < 0:
+		return -1
+	case m > 0:
+		return 1
+	}
+	return 0
+}
+
+// Abs returns the absolute amount.
+// Like the negation -m, it overflows for math.MinInt64 paisa, which is returned unchanged (negative).
+func (m NPR) Abs() NPR {
+	if m < 0 {
+		return -m
+	}
+	return m
+}
+
+// Add returns m + o, or ErrOverflow.
+func (m NPR) Add(o NPR) (NPR, error) {
+	sum := m + o
+	if (o > 0 && sum < m) || (o < 0 && sum > m) {
+		return 0, ErrOverflow
+	}
+	return sum, nil
+}
+
+// Sub returns m - o, or ErrOverflow.
+func (m NPR) Sub(o NPR) (NPR, error) {
+	diff := m - o
+	if (o > 0 && diff > m) || (o < 0 && diff < m) {
+		return 0, ErrOverflow
+	}
+	return diff, nil
+}
+
+// Mul returns m * n, or ErrOverflow.
+func (m NPR) Mul(n int64) (NPR, error) {
+	product := new(big.Int).Mul(big.NewInt(int64(m)), big.NewInt(n))
+	return fromBig(product)
+}
+
+// Div returns m / n rounded with the mode, or ErrOverflow (only for math.MinInt64 paisa / -1).
+// It panics if n is 0.
+//
+// eg. Rs. 10.00 / 3 => Rs. 3.33 (HalfUp), Rs. 3.34 (Up)
+func (m NPR) Div(n int64, mode RoundingMode) (NPR, error) {
+	return m.MulFrac(1, n, mode)
+}
+
+// MulFrac returns m * num / den rounded with the mode, or ErrOverflow.
+// It's meant for the rates, eg. 13% VAT is MulFrac(13, 100, HalfUp). It panics if den is 0.
+func (m NPR) MulFrac(num, den int64, mode RoundingMode) (NPR, error) {
+	if den == 0 {
+		panic("money: division by zero")
+	}
+	product := new(big.Int).Mul(big.NewInt(int64(m)), big.NewInt(num))
+	return fromBig(divRound(product, big.NewInt(den), mode))
+}
+
+// Round returns the amount rounded to a multiple of unit with the mode,
+// eg. Round(Rupee, HalfUp) rounds to the whole rupees. The unit should be positive.
+func (m NPR) Round(unit NPR, mode RoundingMode) NPR {
+	if unit <= 0 {
+		return m
+	}
+	quotient := divRound(big.NewInt(int64(m)), big.NewInt(int64(unit)), mode)
+	rounded, err := fromBig(quotient.Mul(quotient, big.NewInt(int64(unit))))
+	if err != nil {
+		// the rounding away from zero can overflow at the limits, rounds toward zero instead
+		return m - m%unit
+	}
+	return rounded
+}
+
+// Allocate splits the amount into n parts without losing any paisa,
+// the extra paisa are given to the first parts, eg. Rs. 100.00 into 3 parts
+// is Rs. 33.34, Rs. 33.33 and Rs. 33.33. It returns nil if n is less than 1.
+func (m NPR) Allocate(n int) []NPR {
+	if n < 1 {
+		return nil
+	}
+	parts := make([]NPR, n)
+	quotient, remainder := m/NPR(n), m%NPR(n)
+	for i := range parts {
+		parts[i] = quotient
+		if NPR(i) < remainder.Abs() {
+			parts[i] += NPR(remainder.Sign())
+		}
+	}
+	return parts
+}
+
+func fromBig(n *big.Int) (NPR, error) {
+	if !n.IsInt64() {
+		return 0, ErrOverflow
+	}
+	return NPR(n.Int64()), nil
+}
+
+// RoundingMode decides how the amounts are rounded to paisa
+type RoundingMode int
+
+const (
+	HalfUp   RoundingMode = iota // to the nearest, the halves away from zero
+	HalfDown                     // to the nearest, the halves toward zero
+	HalfEven                     // to the nearest, the halves to the even (banker's rounding)
+	Up                           // away from zero
+	Down                         // toward zero (truncation)
+	Ceiling                      // toward positive infinity
+	Floor                        // toward negative infinity
+)
+
+func (mode RoundingMode) String() string {
+	switch mode {
+	case HalfUp:
+		return "HalfUp"
+	case HalfDown:
+		return "HalfDown"
+	case HalfEven:
+		return "HalfEven"
+	case Up:
+		return "Up"
+	case Down:
+		return "Down"
+	case Ceiling:
+		return "Ceiling"
+	case Floor:
+		return "Floor"
+	}
+	return "RoundingMode(" + strconv.Itoa(int(mode)) + ")"
+}
+
+// returns num / den rounded with the mode
+func divRound(num, den *big.Int, mode RoundingMode) *big.Int {
+	quotient, remainder := new(big.Int).QuoRem(num, den, new(big.Int))
+	if remainder.Sign() == 0 {
+		return quotient
+	}
+
+	// sign of the exact result, the quotient is truncated toward zero
+	sign := num.Sign() * den.Sign()
+	// compares 2*|remainder| with |den| for the halves
+	half := new(big.Int).Abs(remainder)
+	half.Lsh(half, 1)
+	cmpHalf := half.Cmp(new(big.Int).Abs(den))
+
+	awayFromZero := false
+	switch mode {
+	case HalfUp:
+		awayFromZero = cmpHalf >= 0
+	case HalfDown:
+		awayFromZero = cmpHalf > 0
+	case HalfEven:
+		awayFromZero = cmpHalf > 0 || (cmpHalf == 0 && quotient.Bit(0) == 1)
+	case Up:
+		awayFromZero = true
+	case Down:
+		awayFromZero = false
+	case Ceiling:
+		awayFromZero = sign > 0
+	case Floor:
+		awayFromZero = sign < 0
+	}
+
+	if awayFromZero {
+		quotient.Add(quotient, big.NewInt(int64(sign)))
+	}
+	return quotient
+}
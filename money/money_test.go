@@ -0,0 +1,150 @@
+package money_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/opensource-nepal/go-nepali/money"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRupees(t *testing.T) {
+	m := money.Rupees(1234) + 50*money.Paisa
+	assert.Equal(t, int64(123450), m.Paisa())
+
+	rupees, paisa := m.Split()
+	assert.Equal(t, int64(1234), rupees)
+	assert.Equal(t, int64(50), paisa)
+
+	rupees, paisa = (-m).Split()
+	assert.Equal(t, int64(-1234), rupees)
+	assert.Equal(t, int64(-50), paisa)
+}
+
+func TestSignAndAbs(t *testing.T) {
+	assert.Equal(t, -1, money.NPR(-5).Sign())
+	assert.Equal(t, 0, money.NPR(0).Sign())
+	assert.Equal(t, 1, money.NPR(5).Sign())
+	assert.True(t, money.NPR(0).IsZero())
+	assert.Equal(t, money.NPR(5), money.NPR(-5).Abs())
+
+	// overflows like the negation
+	assert.Equal(t, money.NPR(math.MinInt64), money.NPR(math.MinInt64).Abs())
+}
+
+func TestCheckedArithmetic(t *testing.T) {
+	sum, err := money.Rupees(100).Add(money.Rupees(50))
+	assert.NoError(t, err)
+	assert.Equal(t, money.Rupees(150), sum)
+
+	diff, err := money.Rupees(100).Sub(money.Rupees(150))
+	assert.NoError(t, err)
+	assert.Equal(t, money.Rupees(-50), diff)
+
+	product, err := money.Rupees(100).Mul(-3)
+	assert.NoError(t, err)
+	assert.Equal(t, money.Rupees(-300), product)
+
+	_, err = money.NPR(math.MaxInt64).Add(1)
+	assert.ErrorIs(t, err, money.ErrOverflow)
+	_, err = money.NPR(math.MinInt64).Add(-1)
+	assert.ErrorIs(t, err, money.ErrOverflow)
+	_, err = money.NPR(math.MinInt64).Sub(1)
+	assert.ErrorIs(t, err, money.ErrOverflow)
+	_, err = money.NPR(math.MaxInt64).Sub(-1)
+	assert.ErrorIs(t, err, money.ErrOverflow)
+	_, err = money.NPR(math.MaxInt64 / 2).Mul(3)
+	assert.ErrorIs(t, err, money.ErrOverflow)
+}
+
+func TestDiv(t *testing.T) {
+	testCases := []struct {
+		m    money.NPR
+		n    int64
+		mode money.RoundingMode
+		want money.NPR
+	}{
+		{1000, 3, money.HalfUp, 333},
+		{1000, 3, money.Up, 334},
+		{-1000, 3, money.Up, -334},
+		{-1000, 3, money.Ceiling, -333},
+		{-1000, 3, money.Floor, -334},
+		{1000, -3, money.Floor, -334},
+		{1000, 4, money.Down, 250},
+	}
+
+	for _, tc := range testCases {
+		got, err := tc.m.Div(tc.n, tc.mode)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.want, got, "%d / %d %s", tc.m, tc.n, tc.mode)
+	}
+	assert.Panics(t, func() { _, _ = money.Rupee.Div(0, money.HalfUp) })
+
+	_, err := money.NPR(math.MinInt64).Div(-1, money.HalfUp)
+	assert.ErrorIs(t, err, money.ErrOverflow)
+}
+
+func TestRoundingModes(t *testing.T) {
+	// amounts of 1/10 paisa, divided by 10 with each mode
+	amounts := []money.NPR{55, 25, 16, 11, 10, -10, -11, -16, -25, -55}
+	want := map[money.RoundingMode][]money.NPR{
+		money.HalfUp:   {6, 3, 2, 1, 1, -1, -1, -2, -3, -6},
+		money.HalfDown: {5, 2, 2, 1, 1, -1, -1, -2, -2, -5},
+		money.HalfEven: {6, 2, 2, 1, 1, -1, -1, -2, -2, -6},
+		money.Up:       {6, 3, 2, 2, 1, -1, -2, -2, -3, -6},
+		money.Down:     {5, 2, 1, 1, 1, -1, -1, -1, -2, -5},
+		money.Ceiling:  {6, 3, 2, 2, 1, -1, -1, -1, -2, -5},
+		money.Floor:    {5, 2, 1, 1, 1, -1, -2, -2, -3, -6},
+	}
+
+	for mode, results := range want {
+		for i, amount := range amounts {
+			got, _ := amount.Div(10, mode)
+			assert.Equal(t, results[i], got, "%d / 10 %s", amount, mode)
+		}
+	}
+}
+
+func TestMulFrac(t *testing.T) {
+	// 13% VAT
+	vat, err := money.MustParse("Rs. 1,234.50").MulFrac(13, 100, money.HalfUp)
+	assert.NoError(t, err)
+	assert.Equal(t, money.MustParse("Rs. 160.49"), vat)
+
+	// the intermediate product doesn't overflow
+	half, err := money.NPR(math.MaxInt64).MulFrac(1000, 2000, money.Down)
+	assert.NoError(t, err)
+	assert.Equal(t, money.NPR(math.MaxInt64/2), half)
+
+	_, err = money.NPR(math.MaxInt64).MulFrac(3, 2, money.Down)
+	assert.ErrorIs(t, err, money.ErrOverflow)
+}
+
+func TestRound(t *testing.T) {
+	m := money.MustParse("Rs. 1,234.50")
+	assert.Equal(t, money.Rupees(1235), m.Round(money.Rupee, money.HalfUp))
+	assert.Equal(t, money.Rupees(1234), m.Round(money.Rupee, money.HalfEven))
+	assert.Equal(t, money.Rupees(1234), m.Round(money.Rupee, money.Floor))
+	assert.Equal(t, money.Rupees(1300), m.Round(100*money.Rupee, money.Up))
+	assert.Equal(t, money.Rupees(-1235), (-m).Round(money.Rupee, money.HalfUp))
+	assert.Equal(t, m, m.Round(0, money.HalfUp))
+	assert.Equal(t, money.NPR(math.MaxInt64-7), money.NPR(math.MaxInt64).Round(money.Rupee, money.Up))
+}
+
+func TestAllocate(t *testing.T) {
+	assert.Equal(t, []money.NPR{3334, 3333, 3333}, money.Rupees(100).Allocate(3))
+	assert.Equal(t, []money.NPR{-3334, -3333, -3333}, money.Rupees(-100).Allocate(3))
+	assert.Equal(t, []money.NPR{1, 1, 0, 0}, money.NPR(2).Allocate(4))
+	assert.Nil(t, money.Rupee.Allocate(0))
+
+	var total money.NPR
+	for _, part := range money.MustParse("Rs. 1,000.01").Allocate(7) {
+		total += part
+	}
+	assert.Equal(t, money.MustParse("Rs. 1,000.01"), total)
+}
+
+func TestRoundingModeString(t *testing.T) {
+	assert.Equal(t, "HalfEven", money.HalfEven.String())
+	assert.Equal(t, "RoundingMode(42)", money.RoundingMode(42).String())
+}
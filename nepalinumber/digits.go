@@ -0,0 +1,52 @@
+// Package nepalinumber
+// This package contains the formatting and parsing of the numbers in the nepali style,
+// ie. grouped in lakh and crore (12,34,56,789.50), with the ascii or devanagari digits (१२,३४,५६,७८९.५०).
+// It also converts the numbers and the amounts of rupees into words and back,
+// eg. "एक लाख पच्चीस हजार रुपैयाँ" or "one lakh twenty-five thousand rupees".
+//
+// USAGE:
+//
+//	nepalinumber.FormatInt(123456789)                     // 12,34,56,789
+//	nepalinumber.ToDevanagari(nepalinumber.FormatFloat(123456789.5, 2)) // १२,३४,५६,७८९.५०
+//	n, err := nepalinumber.ParseInt("१२,३४,५६,७८९")      // 123456789
+package nepalinumber
+
+import (
+	"strings"
+
+	"github.com/opensource-nepal/go-nepali/constants"
+)
+
+// ToDevanagari converts the ascii digits of the string into devanagari digits,
+// the other characters are kept as they are.
+//
+// eg. "12,345.50" => "१२,३४५.५०"
+func ToDevanagari(str string) string {
+	var builder strings.Builder
+	builder.Grow(len(str) * 3)
+	for _, char := range str {
+		if char >= '0' && char <= '9' {
+			builder.WriteString(constants.DevanagariDigits[char-'0'])
+		} else {
+			builder.WriteRune(char)
+		}
+	}
+	return builder.String()
+}
+
+// ToASCII converts the devanagari digits of the string into ascii digits,
+// the other characters are kept as they are.
+//
+// eg. "१२,३४५.५०" => "12,345.50"
+func ToASCII(str string) string {
+	var builder strings.Builder
+	builder.Grow(len(str))
+	for _, char := range str {
+		if char >= '०' && char <= '९' {
+			builder.WriteRune('0' + char - '०')
+		} else {
+			builder.WriteRune(char)
+		}
+	}
+	return builder.String()
+}
@@ -0,0 +1,19 @@
+package nepalinumber_test
+
+import (
+	"testing"
+
+	"github.com/opensource-nepal/go-nepali/nepalinumber"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToDevanagari(t *testing.T) {
+	assert.Equal(t, "१२,३४,५६,७८९.५०", nepalinumber.ToDevanagari("12,34,56,789.50"))
+	assert.Equal(t, "रु. -०", nepalinumber.ToDevanagari("रु. -0"))
+	assert.Equal(t, "", nepalinumber.ToDevanagari(""))
+}
+
+func TestToASCII(t *testing.T) {
+	assert.Equal(t, "12,34,56,789.50", nepalinumber.ToASCII("१२,३४,५६,७८९.५०"))
+	assert.Equal(t, "Rs. 1,234", nepalinumber.ToASCII("Rs. १,234"))
+}
@@ -0,0 +1,95 @@
+package nepalinumber
+
+import (
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// FormatInt returns the integer grouped in the nepali style.
+//
+// eg. 123456789 => "12,34,56,789", -1234 => "-1,234"
+func FormatInt(n int64) string {
+	return group(strconv.FormatInt(n, 10))
+}
+
+// FormatUint returns the unsigned integer grouped in the nepali style.
+func FormatUint(n uint64) string {
+	return group(strconv.FormatUint(n, 10))
+}
+
+// FormatFloat returns the number grouped in the nepali style with prec digits after the decimal point.
+// The prec -1 uses the smallest number of digits necessary to represent the value.
+// NaN and infinities are returned as "NaN", "+Inf" and "-Inf".
+//
+// eg. FormatFloat(123456789.5, 2) => "12,34,56,789.50"
+func FormatFloat(f float64, prec int) string {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return strconv.FormatFloat(f, 'f', prec, 64)
+	}
+	return group(strconv.FormatFloat(f, 'f', prec, 64))
+}
+
+// FormatBigInt returns the big integer grouped in the nepali style.
+func FormatBigInt(n *big.Int) string {
+	return group(n.String())
+}
+
+// FormatBigFloat returns the big number grouped in the nepali style with prec digits after the decimal point.
+// The prec -1 uses the smallest number of digits necessary to represent the value
+// with the precision of the big.Float.
+func FormatBigFloat(f *big.Float, prec int) string {
+	if f.IsInf() {
+		return f.Text('f', prec)
+	}
+	return group(f.Text('f', prec))
+}
+
+// Group groups the integer part of the decimal number string in the nepali style.
+// The digits can be ascii or devanagari, and the string can already be grouped.
+//
+// eg. "123456789.50" => "12,34,56,789.50", "१२३४५६" => "१,२३,४५६"
+func Group(str string) (string, error) {
+	number, err := Normalize(str)
+	if err != nil {
+		return "", err
+	}
+	if str != ToASCII(str) {
+		return ToDevanagari(group(number)), nil
+	}
+	return group(number), nil
+}
+
+// groups the integer part of the plain decimal number string,
+// the last 3 digits and then every 2 digits
+func group(number string) string {
+	sign := ""
+	if number != "" && (number[0] == '-' || number[0] == '+') {
+		sign, number = number[:1], number[1:]
+	}
+	integer, fraction, hasFraction := strings.Cut(number, ".")
+
+	if len(integer) > 3 {
+		var builder strings.Builder
+		head := integer[:len(integer)-3]
+		// the leading group of 1 or 2 digits
+		first := len(head) % 2
+		if first == 0 {
+			first = 2
+		}
+		builder.WriteString(head[:first])
+		for i := first; i < len(head); i += 2 {
+			builder.WriteByte(',')
+			builder.WriteString(head[i : i+2])
+		}
+		builder.WriteByte(',')
+		builder.WriteString(integer[len(integer)-3:])
+		integer = builder.String()
+	}
+
+	if hasFraction {
+		return sign + integer + "." + fraction
+	}
+	return sign + integer
+}
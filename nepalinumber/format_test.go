@@ -0,0 +1,89 @@
+package nepalinumber_test
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/opensource-nepal/go-nepali/nepalinumber"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatInt(t *testing.T) {
+	testCases := []struct {
+		n    int64
+		want string
+	}{
+		{0, "0"},
+		{999, "999"},
+		{1000, "1,000"},
+		{12345, "12,345"},
+		{123456, "1,23,456"},
+		{1234567, "12,34,567"},
+		{123456789, "12,34,56,789"},
+		{-123456789, "-12,34,56,789"},
+		{-100, "-100"},
+		{math.MaxInt64, "92,23,37,20,36,85,47,75,807"},
+		{math.MinInt64, "-92,23,37,20,36,85,47,75,808"},
+	}
+
+	for _, tc := range testCases {
+		assert.Equal(t, tc.want, nepalinumber.FormatInt(tc.n))
+	}
+}
+
+func TestFormatUint(t *testing.T) {
+	assert.Equal(t, "1,84,46,74,40,73,70,95,51,615", nepalinumber.FormatUint(math.MaxUint64))
+}
+
+func TestFormatFloat(t *testing.T) {
+	testCases := []struct {
+		f    float64
+		prec int
+		want string
+	}{
+		{123456789.5, 2, "12,34,56,789.50"},
+		{123456789.5, -1, "12,34,56,789.5"},
+		{123456789.5, 0, "12,34,56,790"},
+		{-1234.567, 2, "-1,234.57"},
+		{0.5, 2, "0.50"},
+		{math.NaN(), 2, "NaN"},
+		{math.Inf(-1), 2, "-Inf"},
+	}
+
+	for _, tc := range testCases {
+		assert.Equal(t, tc.want, nepalinumber.FormatFloat(tc.f, tc.prec))
+	}
+}
+
+func TestFormatBig(t *testing.T) {
+	n, _ := new(big.Int).SetString("-123456789012345678901234567890", 10)
+	assert.Equal(t, "-1,23,45,67,89,01,23,45,67,89,01,23,45,67,890", nepalinumber.FormatBigInt(n))
+
+	f, _, _ := big.ParseFloat("12345678901234567890.125", 10, 200, big.ToNearestEven)
+	// rounded half to even like big.Float.Text
+	assert.Equal(t, "1,23,45,67,89,01,23,45,67,890.12", nepalinumber.FormatBigFloat(f, 2))
+	assert.Equal(t, "1,23,45,67,89,01,23,45,67,890.125", nepalinumber.FormatBigFloat(f, -1))
+}
+
+func TestGroup(t *testing.T) {
+	testCases := []struct {
+		str  string
+		want string
+	}{
+		{"123456789.50", "12,34,56,789.50"},
+		{"12,34,56,789", "12,34,56,789"},
+		{"-1234", "-1,234"},
+		{"१२३४५६", "१,२३,४५६"},
+		{"१२३४५६.७८", "१,२३,४५६.७८"},
+	}
+
+	for _, tc := range testCases {
+		got, err := nepalinumber.Group(tc.str)
+		assert.NoError(t, err, tc.str)
+		assert.Equal(t, tc.want, got)
+	}
+
+	_, err := nepalinumber.Group("123,456")
+	assert.ErrorIs(t, err, nepalinumber.ErrInvalidNumber)
+}
@@ -0,0 +1,130 @@
+package nepalinumber
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidNumber is returned when the string is not a number in the nepali style
+var ErrInvalidNumber = errors.New("invalid number")
+
+// Normalize returns the plain ascii decimal number of the number string in the nepali style,
+// eg. "-१२,३४,५६,७८९.५०" => "-123456789.50".
+//
+// The digits can be ascii or devanagari and the integer part can be grouped in lakh and crore,
+// the misplaced commas (eg. "123,456") are rejected.
+func Normalize(str string) (string, error) {
+	number := ToASCII(strings.TrimSpace(str))
+
+	sign := ""
+	if number != "" && (number[0] == '-' || number[0] == '+') {
+		sign, number = number[:1], number[1:]
+	}
+	integer, fraction, hasFraction := strings.Cut(number, ".")
+
+	if !isDigits(integer) && !isGrouped(integer) {
+		return "", fmt.Errorf("%w %q", ErrInvalidNumber, str)
+	}
+	if hasFraction && !isDigits(fraction) {
+		return "", fmt.Errorf("%w %q", ErrInvalidNumber, str)
+	}
+
+	number = sign + strings.ReplaceAll(integer, ",", "")
+	if hasFraction {
+		number += "." + fraction
+	}
+	return number, nil
+}
+
+// ParseInt parses the integer in the nepali style, eg. "१२,३४,५६,७८९" or "12,34,56,789".
+func ParseInt(str string) (int64, error) {
+	number, err := Normalize(str)
+	if err != nil {
+		return 0, err
+	}
+	n, err := strconv.ParseInt(number, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w %q: %w", ErrInvalidNumber, str, err.(*strconv.NumError).Err)
+	}
+	return n, nil
+}
+
+// ParseFloat parses the decimal number in the nepali style, eg. "१२,३४,५६,७८९.५०".
+func ParseFloat(str string) (float64, error) {
+	number, err := Normalize(str)
+	if err != nil {
+		return 0, err
+	}
+	f, err := strconv.ParseFloat(number, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w %q: %w", ErrInvalidNumber, str, err.(*strconv.NumError).Err)
+	}
+	return f, nil
+}
+
+// ParseBigInt parses the integer of any size in the nepali style.
+func ParseBigInt(str string) (*big.Int, error) {
+	number, err := Normalize(str)
+	if err != nil {
+		return nil, err
+	}
+	n, ok := new(big.Int).SetString(number, 10)
+	if !ok {
+		return nil, fmt.Errorf("%w %q", ErrInvalidNumber, str)
+	}
+	return n, nil
+}
+
+// ParseBigFloat parses the decimal number of any size in the nepali style.
+// The precision of the result is the precision needed for the digits, at least 64 bits.
+func ParseBigFloat(str string) (*big.Float, error) {
+	number, err := Normalize(str)
+	if err != nil {
+		return nil, err
+	}
+	// ~3.33 bits per decimal digit
+	prec := uint(max(64, len(number)*4))
+	f, _, err := big.ParseFloat(number, 10, prec, big.ToNearestEven)
+	if err != nil {
+		return nil, fmt.Errorf("%w %q", ErrInvalidNumber, str)
+	}
+	return f, nil
+}
+
+func isDigits(str string) bool {
+	if str == "" {
+		return false
+	}
+	for i := 0; i < len(str); i++ {
+		if str[i] < '0' || str[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// reports whether the integer is grouped in lakh and crore,
+// ie. the leading group of 1 or 2 digits, the groups of 2 digits and the last group of 3 digits
+func isGrouped(integer string) bool {
+	groups := strings.Split(integer, ",")
+	if len(groups) < 2 {
+		return false
+	}
+
+	last := len(groups) - 1
+	for i, group := range groups {
+		if !isDigits(group) {
+			return false
+		}
+		switch {
+		case i == last && len(group) != 3,
+			i == 0 && len(group) > 2,
+			i != 0 && i != last && len(group) != 2:
+			return false
+		}
+	}
+	return true
+}
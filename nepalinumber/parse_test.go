@@ -0,0 +1,104 @@
+package nepalinumber_test
+
+import (
+	"math/big"
+	"strconv"
+	"testing"
+
+	"github.com/opensource-nepal/go-nepali/nepalinumber"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalize(t *testing.T) {
+	testCases := []struct {
+		str  string
+		want string
+	}{
+		{"12,34,56,789.50", "123456789.50"},
+		{"-१२,३४,५६,७८९.५०", "-123456789.50"},
+		{" +1,234 ", "+1234"},
+		{"1234567", "1234567"},
+		{"1,000", "1000"},
+		{"0.5", "0.5"},
+	}
+
+	for _, tc := range testCases {
+		got, err := nepalinumber.Normalize(tc.str)
+		assert.NoError(t, err, tc.str)
+		assert.Equal(t, tc.want, got, tc.str)
+	}
+}
+
+func TestNormalizeInvalid(t *testing.T) {
+	testCases := []string{
+		"",
+		"-",
+		"abc",
+		"123,456",    // international grouping
+		"1,23,4567",  // last group of 4 digits
+		"123,45,678", // leading group of 3 digits
+		"1,2,34,567", // group of 1 digit
+		",123",
+		"1,234,",
+		"1.",
+		".5",
+		"1.2.3",
+		"1e5",
+		"0x10",
+		"1_000",
+		"१२.५,०",
+	}
+
+	for _, str := range testCases {
+		_, err := nepalinumber.Normalize(str)
+		assert.ErrorIs(t, err, nepalinumber.ErrInvalidNumber, str)
+	}
+}
+
+func TestParseInt(t *testing.T) {
+	n, err := nepalinumber.ParseInt("१२,३४,५६,७८९")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(123456789), n)
+
+	n, err = nepalinumber.ParseInt("-12,34,567")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(-1234567), n)
+
+	_, err = nepalinumber.ParseInt("1,234.5")
+	assert.ErrorIs(t, err, nepalinumber.ErrInvalidNumber)
+
+	_, err = nepalinumber.ParseInt("92,23,37,20,36,85,47,75,808")
+	assert.ErrorIs(t, err, nepalinumber.ErrInvalidNumber)
+	assert.ErrorIs(t, err, strconv.ErrRange)
+}
+
+func TestParseFloat(t *testing.T) {
+	f, err := nepalinumber.ParseFloat("१२,३४,५६,७८९.५०")
+	assert.NoError(t, err)
+	assert.Equal(t, 123456789.5, f)
+
+	_, err = nepalinumber.ParseFloat("Inf")
+	assert.ErrorIs(t, err, nepalinumber.ErrInvalidNumber)
+}
+
+func TestParseBig(t *testing.T) {
+	n, err := nepalinumber.ParseBigInt("-1,23,45,67,89,01,23,45,67,89,01,23,45,67,890")
+	assert.NoError(t, err)
+	want, _ := new(big.Int).SetString("-123456789012345678901234567890", 10)
+	assert.Equal(t, 0, want.Cmp(n))
+
+	f, err := nepalinumber.ParseBigFloat("१,२३,४५,६७,८९,०१,२३,४५,६७,८९०.१२५")
+	assert.NoError(t, err)
+	assert.Equal(t, "12345678901234567890.125", f.Text('f', -1))
+
+	_, err = nepalinumber.ParseBigInt("1.5")
+	assert.ErrorIs(t, err, nepalinumber.ErrInvalidNumber)
+}
+
+func TestFormatParseRoundTrip(t *testing.T) {
+	for _, n := range []int64{0, 7, -42, 1000, 99999, 100000, -9876543210} {
+		got, err := nepalinumber.ParseInt(nepalinumber.ToDevanagari(nepalinumber.FormatInt(n)))
+		assert.NoError(t, err)
+		assert.Equal(t, n, got)
+	}
+}
@@ -0,0 +1,367 @@
+package nepalinumber
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Language of the words
+type Language int
+
+const (
+	English Language = iota // indian english numbering, eg. "one lakh twenty-five thousand"
+	Nepali                  // devanagari script, eg. "एक लाख पच्चीस हजार"
+)
+
+// ErrInvalidWords is returned when the words are not a number
+var ErrInvalidWords = errors.New("invalid number words")
+
+// nepali words of 0 to 99
+var nepaliWords = [100]string{
+	"शून्य", "एक", "दुई", "तीन", "चार", "पाँच", "छ", "सात", "आठ", "नौ",
+	"दस", "एघार", "बाह्र", "तेह्र", "चौध", "पन्ध्र", "सोह्र", "सत्र", "अठार", "उन्नाइस",
+	"बीस", "एक्काइस", "बाइस", "तेइस", "चौबीस", "पच्चीस", "छब्बीस", "सत्ताइस", "अठ्ठाइस", "उनन्तीस",
+	"तीस", "एकतीस", "बत्तीस", "तेत्तीस", "चौँतीस", "पैँतीस", "छत्तीस", "सैँतीस", "अठतीस", "उनन्चालीस",
+	"चालीस", "एकचालीस", "बयालीस", "त्रियालीस", "चवालीस", "पैँतालीस", "छयालीस", "सत्चालीस", "अठचालीस", "उनन्चास",
+	"पचास", "एकाउन्न", "बाउन्न", "त्रिपन्न", "चवन्न", "पचपन्न", "छपन्न", "सन्ताउन्न", "अन्ठाउन्न", "उनन्साठी",
+	"साठी", "एकसट्ठी", "बयसट्ठी", "त्रिसट्ठी", "चौसट्ठी", "पैँसट्ठी", "छयसट्ठी", "सतसट्ठी", "अठसट्ठी", "उनन्सत्तरी",
+	"सत्तरी", "एकहत्तर", "बहत्तर", "त्रिहत्तर", "चौहत्तर", "पचहत्तर", "छयहत्तर", "सतहत्तर", "अठहत्तर", "उनासी",
+	"असी", "एकासी", "बयासी", "त्रियासी", "चौरासी", "पचासी", "छयासी", "सतासी", "अठासी", "उनान्नब्बे",
+	"नब्बे", "एकान्नब्बे", "बयानब्बे", "त्रियानब्बे", "चौरानब्बे", "पन्चानब्बे", "छयानब्बे", "सन्तानब्बे", "अन्ठानब्बे", "उनान्सय",
+}
+
+var englishOnes = [20]string{
+	"zero", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine",
+	"ten", "eleven", "twelve", "thirteen", "fourteen", "fifteen", "sixteen", "seventeen", "eighteen", "nineteen",
+}
+
+var englishTens = [10]string{"", "", "twenty", "thirty", "forty", "fifty", "sixty", "seventy", "eighty", "ninety"}
+
+// scale of the indian numbering, from the biggest
+type scale struct {
+	value   uint64
+	english string
+	nepali  string
+}
+
+var scales = []scale{
+	{1e17, "shankh", "शंख"},
+	{1e15, "padma", "पद्म"},
+	{1e13, "neel", "नील"},
+	{1e11, "kharab", "खर्ब"},
+	{1e9, "arab", "अर्ब"},
+	{1e7, "crore", "करोड"},
+	{1e5, "lakh", "लाख"},
+	{1e3, "thousand", "हजार"},
+	{1e2, "hundred", "सय"},
+}
+
+// ToWords returns the integer in words, in the indian numbering (lakh, crore, arab, kharab, ...).
+//
+// eg. 125300 => "one lakh twenty-five thousand three hundred" (English)
+// and "एक लाख पच्चीस हजार तीन सय" (Nepali)
+func ToWords(n int64, language Language) string {
+	words := numberWords(absUint(n), language)
+	if n < 0 {
+		words = append([]string{minusWord(language)}, words...)
+	}
+	return strings.Join(words, " ")
+}
+
+// RupeesToWords returns the amount of rupees in words, the amount is in paisa (1/100 rupee).
+//
+// eg. 12530050 => "one lakh twenty-five thousand three hundred rupees and fifty paisa" (English)
+// and "एक लाख पच्चीस हजार तीन सय रुपैयाँ पचास पैसा" (Nepali)
+func RupeesToWords(paisa int64, language Language) string {
+	rupees, rem := absUint(paisa)/100, absUint(paisa)%100
+
+	var words []string
+	if paisa < 0 {
+		words = append(words, minusWord(language))
+	}
+	if rupees > 0 || rem == 0 {
+		words = append(words, numberWords(rupees, language)...)
+		words = append(words, rupeeWord(language, rupees))
+	}
+	if rem > 0 {
+		if rupees > 0 && language == English {
+			words = append(words, "and")
+		}
+		words = append(words, numberWords(rem, language)...)
+		words = append(words, paisaWord(language))
+	}
+	return strings.Join(words, " ")
+}
+
+func numberWords(n uint64, language Language) []string {
+	if n == 0 {
+		return []string{belowHundred(0, language)}
+	}
+
+	var words []string
+	for _, s := range scales {
+		if count := n / s.value; count > 0 {
+			// the count is less than 100, at most 92 shankh for math.MaxInt64
+			words = append(words, numberWords(count, language)...)
+			if language == Nepali {
+				words = append(words, s.nepali)
+			} else {
+				words = append(words, s.english)
+			}
+			n %= s.value
+		}
+	}
+	if n > 0 {
+		words = append(words, belowHundred(n, language))
+	}
+	return words
+}
+
+func belowHundred(n uint64, language Language) string {
+	switch {
+	case language == Nepali:
+		return nepaliWords[n]
+	case n < 20:
+		return englishOnes[n]
+	case n%10 == 0:
+		return englishTens[n/10]
+	default:
+		return englishTens[n/10] + "-" + englishOnes[n%10]
+	}
+}
+
+func minusWord(language Language) string {
+	if language == Nepali {
+		return "ऋण"
+	}
+	return "minus"
+}
+
+func rupeeWord(language Language, rupees uint64) string {
+	switch {
+	case language == Nepali:
+		return "रुपैयाँ"
+	case rupees == 1:
+		return "rupee"
+	default:
+		return "rupees"
+	}
+}
+
+func paisaWord(language Language) string {
+	if language == Nepali {
+		return "पैसा"
+	}
+	return "paisa"
+}
+
+func absUint(n int64) uint64 {
+	if n < 0 {
+		return uint64(-(n + 1)) + 1
+	}
+	return uint64(n)
+}
+
+// ParseWords parses the integer in words of either language, eg. "one lakh twenty-five thousand"
+// or "एक लाख पच्चीस हजार". It is the reverse of ToWords().
+func ParseWords(words string) (int64, error) {
+	tokens := tokenize(words)
+	negative := len(tokens) > 0 && minusWords[tokens[0]]
+	if negative {
+		tokens = tokens[1:]
+	}
+
+	magnitude, err := parseTokens(tokens)
+	if err != nil {
+		return 0, fmt.Errorf("%w %q: %w", ErrInvalidWords, words, err)
+	}
+	n, err := withSign(magnitude, negative)
+	if err != nil {
+		return 0, fmt.Errorf("%w %q: %w", ErrInvalidWords, words, err)
+	}
+	return n, nil
+}
+
+// ParseRupeesWords parses the amount of rupees in words of either language and returns it in paisa,
+// eg. "one lakh rupees and fifty paisa" or "एक लाख रुपैयाँ पचास पैसा" => 10000050.
+// It is the reverse of RupeesToWords(), the trailing "only" or "मात्र" is ignored.
+func ParseRupeesWords(words string) (int64, error) {
+	tokens := tokenize(words)
+	if n := len(tokens); n > 0 && (tokens[n-1] == "only" || tokens[n-1] == "मात्र") {
+		tokens = tokens[:n-1]
+	}
+
+	negative := len(tokens) > 0 && minusWords[tokens[0]]
+	if negative {
+		tokens = tokens[1:]
+	}
+
+	// [RUPEES rupees] [PAISA paisa]
+	var rupeeTokens, paisaTokens []string
+	rest := tokens
+	for i, token := range rest {
+		if rupeeWords[token] {
+			rupeeTokens, rest = rest[:i], rest[i+1:]
+			break
+		}
+	}
+	if n := len(rest); n > 0 {
+		if !paisaWords[rest[n-1]] {
+			return 0, fmt.Errorf("%w %q: missing rupees or paisa", ErrInvalidWords, words)
+		}
+		paisaTokens = rest[:n-1]
+	}
+	if rupeeTokens == nil && paisaTokens == nil {
+		return 0, fmt.Errorf("%w %q", ErrInvalidWords, words)
+	}
+
+	var rupees, paisa uint64
+	var err error
+	if rupeeTokens != nil {
+		if rupees, err = parseTokens(rupeeTokens); err != nil {
+			return 0, fmt.Errorf("%w %q: %w", ErrInvalidWords, words, err)
+		}
+	}
+	if paisaTokens != nil {
+		if paisa, err = parseTokens(paisaTokens); err != nil {
+			return 0, fmt.Errorf("%w %q: %w", ErrInvalidWords, words, err)
+		}
+		if paisa > 99 {
+			return 0, fmt.Errorf("%w %q: paisa should be less than 100", ErrInvalidWords, words)
+		}
+	}
+	if rupees > (math.MaxUint64-paisa)/100 {
+		return 0, fmt.Errorf("%w %q: amount is too large", ErrInvalidWords, words)
+	}
+
+	amount, err := withSign(rupees*100+paisa, negative)
+	if err != nil {
+		return 0, fmt.Errorf("%w %q: amount is too large", ErrInvalidWords, words)
+	}
+	return amount, nil
+}
+
+// returns the integer of the magnitude and the sign,
+// the magnitude of the negative integers can be one more than math.MaxInt64 (math.MinInt64)
+func withSign(magnitude uint64, negative bool) (int64, error) {
+	if negative {
+		if magnitude > math.MaxInt64+1 {
+			return 0, errors.New("number is too large")
+		}
+		return int64(-magnitude), nil
+	}
+	if magnitude > math.MaxInt64 {
+		return 0, errors.New("number is too large")
+	}
+	return int64(magnitude), nil
+}
+
+var (
+	minusWords = map[string]bool{"minus": true, "negative": true, "ऋण": true}
+	rupeeWords = map[string]bool{"rupees": true, "rupee": true, "रुपैयाँ": true, "रुपियाँ": true, "रूपैयाँ": true}
+	paisaWords = map[string]bool{"paisa": true, "paise": true, "पैसा": true}
+)
+
+// values of the words of 0 to 99, and the scales, by word
+var (
+	wordValues  = map[string]uint64{}
+	scaleValues = map[string]uint64{}
+)
+
+func init() {
+	for n, word := range nepaliWords {
+		wordValues[word] = uint64(n)
+	}
+	for n, word := range englishOnes {
+		wordValues[word] = uint64(n)
+	}
+	for n, word := range englishTens[2:] {
+		wordValues[word] = uint64(n+2) * 10
+	}
+	for _, s := range scales {
+		scaleValues[s.english] = s.value
+		scaleValues[s.nepali] = s.value
+	}
+
+	// alternative spellings
+	for word, n := range map[string]uint64{"दश": 10, "पांच": 5, "सुन्य": 0} {
+		wordValues[word] = n
+	}
+	for word, n := range map[string]uint64{"अरब": 1e9, "खरब": 1e11, "सये": 1e2, "हज़ार": 1e3, "lac": 1e5, "lakhs": 1e5, "crores": 1e7} {
+		scaleValues[word] = n
+	}
+}
+
+// splits the words into lower case tokens without "and",
+// eg. "Twenty-Five rupees and ten paisa" => "twenty", "five", "rupees", "ten", "paisa"
+func tokenize(words string) []string {
+	fields := strings.FieldsFunc(strings.ToLower(words), func(r rune) bool {
+		return r == ' ' || r == '\t' || r == '\n' || r == ',' || r == '-' || r == '।'
+	})
+
+	tokens := fields[:0]
+	for _, field := range fields {
+		if field != "and" && field != "र" {
+			tokens = append(tokens, field)
+		}
+	}
+	return tokens
+}
+
+// parses the tokens of a non-negative integer into its magnitude
+func parseTokens(tokens []string) (uint64, error) {
+	if len(tokens) == 0 {
+		return 0, errors.New("missing number")
+	}
+
+	var (
+		total, current uint64
+		lastScale      uint64 = math.MaxUint64
+		hasCurrent     bool
+	)
+	for i, token := range tokens {
+		if value, ok := wordValues[token]; ok {
+			if value == 0 && len(tokens) > 1 {
+				return 0, errors.New("zero within a number")
+			}
+			// eg. "twenty five", but not "five twenty"
+			if hasCurrent && !(current >= 20 && current%10 == 0 && value < 10) {
+				return 0, fmt.Errorf("unexpected %q", token)
+			}
+			current += value
+			hasCurrent = true
+			continue
+		}
+
+		value, ok := scaleValues[token]
+		if !ok {
+			return 0, fmt.Errorf("unknown word %q", token)
+		}
+		if !hasCurrent {
+			// eg. "hundred" for "one hundred"
+			if i != 0 {
+				return 0, fmt.Errorf("unexpected %q", token)
+			}
+			current = 1
+		}
+		if value >= lastScale {
+			return 0, fmt.Errorf("unexpected %q", token)
+		}
+		if value == 100 {
+			// hundreds are counted within the lower groups, eg. "three hundred twenty"
+			if current >= 10 {
+				return 0, fmt.Errorf("unexpected %q", token)
+			}
+			total += current * 100
+		} else {
+			total += current * value
+		}
+		lastScale = value
+		current, hasCurrent = 0, false
+	}
+	total += current
+
+	return total, nil
+}
@@ -0,0 +1,183 @@
+package nepalinumber_test
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/opensource-nepal/go-nepali/nepalinumber"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToWords(t *testing.T) {
+	testCases := []struct {
+		n       int64
+		english string
+		nepali  string
+	}{
+		{0, "zero", "शून्य"},
+		{7, "seven", "सात"},
+		{19, "nineteen", "उन्नाइस"},
+		{40, "forty", "चालीस"},
+		{99, "ninety-nine", "उनान्सय"},
+		{100, "one hundred", "एक सय"},
+		{305, "three hundred five", "तीन सय पाँच"},
+		{1000, "one thousand", "एक हजार"},
+		{125300, "one lakh twenty-five thousand three hundred", "एक लाख पच्चीस हजार तीन सय"},
+		{10000001, "one crore one", "एक करोड एक"},
+		{123456789, "twelve crore thirty-four lakh fifty-six thousand seven hundred eighty-nine",
+			"बाह्र करोड चौँतीस लाख छपन्न हजार सात सय उनान्नब्बे"},
+		{2500000000, "two arab fifty crore", "दुई अर्ब पचास करोड"},
+		{300000000000, "three kharab", "तीन खर्ब"},
+		{-1500, "minus one thousand five hundred", "ऋण एक हजार पाँच सय"},
+	}
+
+	for _, tc := range testCases {
+		assert.Equal(t, tc.english, nepalinumber.ToWords(tc.n, nepalinumber.English))
+		assert.Equal(t, tc.nepali, nepalinumber.ToWords(tc.n, nepalinumber.Nepali))
+	}
+}
+
+func TestToWordsLimits(t *testing.T) {
+	assert.Equal(t,
+		"ninety-two shankh twenty-three padma thirty-seven neel twenty kharab thirty-six arab eighty-five crore forty-seven lakh seventy-five thousand eight hundred seven",
+		nepalinumber.ToWords(math.MaxInt64, nepalinumber.English))
+	assert.Equal(t,
+		"minus ninety-two shankh twenty-three padma thirty-seven neel twenty kharab thirty-six arab eighty-five crore forty-seven lakh seventy-five thousand eight hundred eight",
+		nepalinumber.ToWords(math.MinInt64, nepalinumber.English))
+}
+
+func TestRupeesToWords(t *testing.T) {
+	testCases := []struct {
+		paisa   int64
+		english string
+		nepali  string
+	}{
+		{12530050, "one lakh twenty-five thousand three hundred rupees and fifty paisa", "एक लाख पच्चीस हजार तीन सय रुपैयाँ पचास पैसा"},
+		{100, "one rupee", "एक रुपैयाँ"},
+		{500000, "five thousand rupees", "पाँच हजार रुपैयाँ"},
+		{75, "seventy-five paisa", "पचहत्तर पैसा"},
+		{0, "zero rupees", "शून्य रुपैयाँ"},
+		{-250, "minus two rupees and fifty paisa", "ऋण दुई रुपैयाँ पचास पैसा"},
+	}
+
+	for _, tc := range testCases {
+		assert.Equal(t, tc.english, nepalinumber.RupeesToWords(tc.paisa, nepalinumber.English))
+		assert.Equal(t, tc.nepali, nepalinumber.RupeesToWords(tc.paisa, nepalinumber.Nepali))
+	}
+}
+
+func TestParseWords(t *testing.T) {
+	testCases := []struct {
+		words string
+		want  int64
+	}{
+		{"zero", 0},
+		{"शून्य", 0},
+		{"One Lakh Twenty-Five Thousand", 125000},
+		{"one lakh twenty five thousand three hundred and five", 125305},
+		{"एक लाख पच्चीस हजार तीन सय", 125300},
+		{"hundred", 100},
+		{"बाह्र करोड चौँतीस लाख छपन्न हजार सात सय उनान्नब्बे", 123456789},
+		{"दुई अरब", 2000000000},
+		{"minus one thousand", -1000},
+		{"ऋण पाँच", -5},
+	}
+
+	for _, tc := range testCases {
+		got, err := nepalinumber.ParseWords(tc.words)
+		assert.NoError(t, err, tc.words)
+		assert.Equal(t, tc.want, got, tc.words)
+	}
+}
+
+func TestParseWordsInvalid(t *testing.T) {
+	testCases := []string{
+		"",
+		"minus",
+		"one two",
+		"five twenty",
+		"twenty twenty",
+		"one thousand one lakh", // scales in increasing order
+		"one lakh two lakh",     // repeated scale
+		"five hundred thousand", // hundreds of thousand
+		"twelve hundred",
+		"zero thousand",
+		"one zillion",
+		"ninety-three shankh",       // overflow
+		"minus ninety-three shankh", // overflow
+	}
+
+	for _, words := range testCases {
+		_, err := nepalinumber.ParseWords(words)
+		assert.ErrorIs(t, err, nepalinumber.ErrInvalidWords, words)
+	}
+}
+
+func TestParseWordsLimits(t *testing.T) {
+	minWords := nepalinumber.ToWords(math.MinInt64, nepalinumber.English)
+
+	got, err := nepalinumber.ParseWords(minWords)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(math.MinInt64), got)
+
+	// math.MaxInt64 + 1 is too large without the minus
+	_, err = nepalinumber.ParseWords(strings.TrimPrefix(minWords, "minus "))
+	assert.ErrorIs(t, err, nepalinumber.ErrInvalidWords)
+}
+
+func TestParseRupeesWords(t *testing.T) {
+	testCases := []struct {
+		words string
+		want  int64
+	}{
+		{"one lakh twenty-five thousand three hundred rupees and fifty paisa", 12530050},
+		{"एक लाख पच्चीस हजार तीन सय रुपैयाँ पचास पैसा", 12530050},
+		{"One Rupee Only", 100},
+		{"पाँच हजार रुपैयाँ मात्र", 500000},
+		{"seventy-five paisa", 75},
+		{"minus two rupees and fifty paisa", -250},
+	}
+
+	for _, tc := range testCases {
+		got, err := nepalinumber.ParseRupeesWords(tc.words)
+		assert.NoError(t, err, tc.words)
+		assert.Equal(t, tc.want, got, tc.words)
+	}
+}
+
+func TestParseRupeesWordsInvalid(t *testing.T) {
+	testCases := []string{
+		"",
+		"only",
+		"one lakh",
+		"rupees",
+		"one hundred paisa",
+		"five rupees fifty",
+		"five rupees and minus fifty paisa",
+	}
+
+	for _, words := range testCases {
+		_, err := nepalinumber.ParseRupeesWords(words)
+		assert.ErrorIs(t, err, nepalinumber.ErrInvalidWords, words)
+	}
+}
+
+func TestWordsRoundTrip(t *testing.T) {
+	numbers := []int64{54321, 100000, 9999999, 12345678901, math.MaxInt64, math.MinInt64, math.MinInt64 + 1, -42}
+	for n := range int64(1000) {
+		numbers = append(numbers, n)
+	}
+
+	for _, n := range numbers {
+		for _, language := range []nepalinumber.Language{nepalinumber.English, nepalinumber.Nepali} {
+			got, err := nepalinumber.ParseWords(nepalinumber.ToWords(n, language))
+			assert.NoError(t, err)
+			assert.Equal(t, n, got)
+
+			got, err = nepalinumber.ParseRupeesWords(nepalinumber.RupeesToWords(n, language))
+			assert.NoError(t, err)
+			assert.Equal(t, n, got)
+		}
+	}
+}
@@ -0,0 +1,197 @@
+// Package nepalipb
+// This package contains the protocol buffer messages of the nepali dates (nepali.proto),
+// the generated Go code with the gRPC service, and the conversions from and into the nepalitime types.
+//
+// USAGE:
+//
+//	msg := nepalipb.NewNepaliTime(nepaliTime)
+//	nepaliTime, err := msg.AsNepaliTime()
+//
+//	ts := nepalipb.NewTimestamp(nepaliTime) // google.protobuf.Timestamp
+//	nepaliTime, err := nepalipb.FromTimestamp(ts)
+package nepalipb
+
+//go:generate protoc -I .. --go_out=.. --go_opt=paths=source_relative --go-grpc_out=.. --go-grpc_opt=paths=source_relative nepalipb/nepali.proto
+
+import (
+	"errors"
+	"time"
+
+	"github.com/opensource-nepal/go-nepali/constants"
+	"github.com/opensource-nepal/go-nepali/nepalitime"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// NewNepaliDate returns the message of the nepali date.
+func NewNepaliDate(date nepalitime.NepaliDate) *NepaliDate {
+	year, month, day := date.Date()
+	return &NepaliDate{Year: int32(year), Month: int32(month), Day: int32(day)}
+}
+
+// AsNepaliDate returns the nepali date of the message.
+// It returns error if the message is nil or the date is invalid.
+func (x *NepaliDate) AsNepaliDate() (nepalitime.NepaliDate, error) {
+	if x == nil {
+		return nepalitime.NepaliDate{}, errors.New("missing date")
+	}
+	return nepalitime.NewDate(int(x.GetYear()), int(x.GetMonth()), int(x.GetDay()))
+}
+
+// NewNepaliTime returns the message of the nepali time,
+// with the time zone and the UTC offset of the nepali time.
+// The time zone is empty for time.Local, the offset is used instead.
+func NewNepaliTime(nepaliTime *nepalitime.NepaliTime) *NepaliTime {
+	hour, min, sec := nepaliTime.Clock()
+	englishTime := nepaliTime.GetEnglishTime()
+	_, offset := englishTime.Zone()
+
+	timeZone := englishTime.Location().String()
+	if englishTime.Location() == time.Local {
+		timeZone = ""
+	}
+
+	return &NepaliTime{
+		Date:             NewNepaliDate(nepaliTime.NepaliDate()),
+		Hour:             int32(hour),
+		Minute:           int32(min),
+		Second:           int32(sec),
+		Nanos:            int32(nepaliTime.Nanosecond()),
+		TimeZone:         timeZone,
+		UtcOffsetSeconds: proto.Int32(int32(offset)),
+	}
+}
+
+// AsNepaliTime returns the nepali time of the message.
+// It returns error if the message is nil, or the date, the clock or the time zone is invalid.
+func (x *NepaliTime) AsNepaliTime() (*nepalitime.NepaliTime, error) {
+	if x == nil {
+		return nil, errors.New("missing time")
+	}
+	date, err := x.GetDate().AsNepaliDate()
+	if err != nil {
+		return nil, err
+	}
+	if x.GetHour() < 0 || x.GetHour() > 23 ||
+		x.GetMinute() < 0 || x.GetMinute() > 59 ||
+		x.GetSecond() < 0 || x.GetSecond() > 59 ||
+		x.GetNanos() < 0 || x.GetNanos() > 999_999_999 {
+		return nil, errors.New("invalid clock")
+	}
+	loc, err := x.location()
+	if err != nil {
+		return nil, err
+	}
+
+	year, month, day := date.Date()
+	return nepalitime.DateIn(year, month, day,
+		int(x.GetHour()), int(x.GetMinute()), int(x.GetSecond()), int(x.GetNanos()), loc)
+}
+
+// location of the message, the IANA time zone if valid else the fixed zone of the UTC offset
+func (x *NepaliTime) location() (*time.Location, error) {
+	name := x.GetTimeZone()
+	if x.UtcOffsetSeconds == nil {
+		return LoadLocation(name)
+	}
+	if name != "" {
+		if loc, err := LoadLocation(name); err == nil {
+			return loc, nil
+		}
+	}
+
+	offset := int(x.GetUtcOffsetSeconds())
+	if offset <= -24*60*60 || offset >= 24*60*60 {
+		return nil, errors.New("invalid UTC offset")
+	}
+	if name == "" {
+		name = offsetZoneName(offset)
+	}
+	return time.FixedZone(name, offset), nil
+}
+
+// name of the fixed zone of the UTC offset, same as the zones parsed with %z,
+// eg. "+0545" and "UTC" for the zero offset
+func offsetZoneName(offset int) string {
+	if offset == 0 {
+		return "UTC"
+	}
+	return time.Unix(0, 0).In(time.FixedZone("", offset)).Format("-0700")
+}
+
+// AsTimestamp returns the timestamp of the nepali time of the message.
+func (x *NepaliTime) AsTimestamp() (*timestamppb.Timestamp, error) {
+	nepaliTime, err := x.AsNepaliTime()
+	if err != nil {
+		return nil, err
+	}
+	return NewTimestamp(nepaliTime), nil
+}
+
+// NewTimestamp returns the timestamp (google.protobuf.Timestamp) of the nepali time.
+func NewTimestamp(nepaliTime *nepalitime.NepaliTime) *timestamppb.Timestamp {
+	return timestamppb.New(nepaliTime.GetEnglishTime())
+}
+
+// FromTimestamp returns the nepali time of the timestamp in Asia/Kathmandu.
+// It returns error if the timestamp is nil, invalid or out of the supported range.
+func FromTimestamp(ts *timestamppb.Timestamp) (*nepalitime.NepaliTime, error) {
+	return FromTimestampIn(ts, nepalitime.GetNepaliLocation())
+}
+
+// FromTimestampIn is same as FromTimestamp() but the nepali time is in the given location.
+func FromTimestampIn(ts *timestamppb.Timestamp, loc *time.Location) (*nepalitime.NepaliTime, error) {
+	if ts == nil {
+		return nil, errors.New("missing timestamp")
+	}
+	if err := ts.CheckValid(); err != nil {
+		return nil, err
+	}
+	return nepalitime.FromEnglishTimeIn(ts.AsTime(), loc)
+}
+
+// NewFiscalYear returns the fiscal year starting in the nepali year,
+// eg. NewFiscalYear(2080) is FY 2080/81.
+func NewFiscalYear(startYear int) (*FiscalYear, error) {
+	start, err := nepalitime.NewDate(startYear, 4, 1)
+	if err != nil {
+		return nil, err
+	}
+	return FiscalYearOf(start)
+}
+
+// FiscalYearOf returns the fiscal year of the nepali date.
+func FiscalYearOf(date nepalitime.NepaliDate) (*FiscalYear, error) {
+	fiscalYear, err := nepalitime.RangeOf(date, nepalitime.FiscalYear)
+	if err != nil {
+		return nil, err
+	}
+	return &FiscalYear{
+		StartYear: int32(fiscalYear.Start().Year()),
+		Start:     NewNepaliDate(fiscalYear.Start()),
+		End:       NewNepaliDate(fiscalYear.End()),
+	}, nil
+}
+
+// AsRange returns the dates of the fiscal year of the message.
+func (x *FiscalYear) AsRange() (nepalitime.Range, error) {
+	if x == nil {
+		return nepalitime.Range{}, errors.New("missing fiscal year")
+	}
+	fiscalYear, err := NewFiscalYear(int(x.GetStartYear()))
+	if err != nil {
+		return nepalitime.Range{}, err
+	}
+	start, _ := fiscalYear.GetStart().AsNepaliDate()
+	end, _ := fiscalYear.GetEnd().AsNepaliDate()
+	return nepalitime.NewRange(start, end)
+}
+
+// LoadLocation returns the location of the IANA time zone of the messages,
+// Asia/Kathmandu if empty.
+func LoadLocation(name string) (*time.Location, error) {
+	if name == "" || name == constants.Timezone {
+		return nepalitime.GetNepaliLocation(), nil
+	}
+	return time.LoadLocation(name)
+}
@@ -0,0 +1,186 @@
+package nepalipb_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/opensource-nepal/go-nepali/nepalipb"
+	"github.com/opensource-nepal/go-nepali/nepalitime"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestNepaliDateRoundTrip(t *testing.T) {
+	date, _ := nepalitime.NewDate(2079, 10, 14)
+
+	msg := nepalipb.NewNepaliDate(date)
+	assert.Equal(t, int32(2079), msg.GetYear())
+	assert.Equal(t, int32(10), msg.GetMonth())
+	assert.Equal(t, int32(14), msg.GetDay())
+
+	got, err := msg.AsNepaliDate()
+	assert.NoError(t, err)
+	assert.Equal(t, date, got)
+}
+
+func TestNepaliDateAsNepaliDateInvalid(t *testing.T) {
+	var nilDate *nepalipb.NepaliDate
+	_, err := nilDate.AsNepaliDate()
+	assert.EqualError(t, err, "missing date")
+
+	_, err = (&nepalipb.NepaliDate{Year: 2079, Month: 13, Day: 1}).AsNepaliDate()
+	assert.Error(t, err)
+}
+
+func TestNepaliTimeRoundTrip(t *testing.T) {
+	nepaliTime, _ := nepalitime.Date(2079, 10, 14, 16, 23, 17, 500)
+
+	msg := nepalipb.NewNepaliTime(nepaliTime)
+	assert.True(t, proto.Equal(&nepalipb.NepaliTime{
+		Date:             &nepalipb.NepaliDate{Year: 2079, Month: 10, Day: 14},
+		Hour:             16,
+		Minute:           23,
+		Second:           17,
+		Nanos:            500,
+		TimeZone:         "Asia/Kathmandu",
+		UtcOffsetSeconds: proto.Int32(20700),
+	}, msg), msg.String())
+
+	got, err := msg.AsNepaliTime()
+	assert.NoError(t, err)
+	assert.True(t, nepaliTime.GetEnglishTime().Equal(got.GetEnglishTime()))
+	assert.Equal(t, nepaliTime.String(), got.String())
+}
+
+func TestNepaliTimeWithTimeZone(t *testing.T) {
+	msg := &nepalipb.NepaliTime{
+		Date:     &nepalipb.NepaliDate{Year: 2079, Month: 10, Day: 14},
+		Hour:     10,
+		TimeZone: "UTC",
+	}
+
+	got, err := msg.AsNepaliTime()
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2023, 1, 28, 10, 0, 0, 0, time.UTC), got.GetEnglishTime())
+}
+
+func TestNepaliTimeRoundTripWithUTCOffset(t *testing.T) {
+	testCases := []struct {
+		datetime string
+		zone     string
+	}{
+		{"2079-10-14 10:00 +0000", "UTC"},
+		{"2079-10-14 10:00 +0530", "+0530"},
+		{"2079-10-14 10:00 -0230", "-0230"},
+	}
+
+	for _, tc := range testCases {
+		nepaliTime, err := nepalitime.Parse(tc.datetime, "%Y-%m-%d %H:%M %z")
+		assert.NoError(t, err)
+
+		got, err := nepalipb.NewNepaliTime(nepaliTime).AsNepaliTime()
+		assert.NoError(t, err, tc.datetime)
+		assert.True(t, nepaliTime.GetEnglishTime().Equal(got.GetEnglishTime()), tc.datetime)
+		assert.Equal(t, tc.datetime, got.Format("%Y-%m-%d %H:%M %z"))
+		assert.Equal(t, tc.zone, got.GetEnglishTime().Location().String())
+	}
+}
+
+func TestNepaliTimeWithUTCOffset(t *testing.T) {
+	date := &nepalipb.NepaliDate{Year: 2079, Month: 10, Day: 14}
+
+	// the offset is used without the time zone
+	got, err := (&nepalipb.NepaliTime{Date: date, Hour: 10, UtcOffsetSeconds: proto.Int32(3600)}).AsNepaliTime()
+	assert.NoError(t, err)
+	assert.Equal(t, "2023-01-28T10:00:00+01:00", got.GetEnglishTime().Format(time.RFC3339))
+	assert.Equal(t, "+0100", got.GetEnglishTime().Location().String())
+
+	// the unnamed fixed zones
+	nepaliTime, _ := nepalitime.FromEnglishTimeIn(time.Date(2023, 1, 28, 10, 0, 0, 0, time.UTC), time.FixedZone("", -5*3600))
+	got, err = nepalipb.NewNepaliTime(nepaliTime).AsNepaliTime()
+	assert.NoError(t, err)
+	assert.True(t, nepaliTime.GetEnglishTime().Equal(got.GetEnglishTime()))
+
+	// the IANA time zone is preferred
+	got, err = (&nepalipb.NepaliTime{Date: date, Hour: 10, TimeZone: "UTC", UtcOffsetSeconds: proto.Int32(3600)}).AsNepaliTime()
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2023, 1, 28, 10, 0, 0, 0, time.UTC), got.GetEnglishTime())
+
+	// the other names are kept for the fixed zone
+	got, err = (&nepalipb.NepaliTime{Date: date, Hour: 10, TimeZone: "IST", UtcOffsetSeconds: proto.Int32(19800)}).AsNepaliTime()
+	assert.NoError(t, err)
+	assert.Equal(t, "2023-01-28 10:00:00 +0530 IST", got.GetEnglishTime().Format("2006-01-02 15:04:05 -0700 MST"))
+}
+
+func TestNepaliTimeAsNepaliTimeInvalid(t *testing.T) {
+	date := &nepalipb.NepaliDate{Year: 2079, Month: 10, Day: 14}
+	testCases := []struct {
+		msg *nepalipb.NepaliTime
+		err string
+	}{
+		{nil, "missing time"},
+		{&nepalipb.NepaliTime{}, "missing date"},
+		{&nepalipb.NepaliTime{Date: date, Hour: 24}, "invalid clock"},
+		{&nepalipb.NepaliTime{Date: date, Minute: -1}, "invalid clock"},
+		{&nepalipb.NepaliTime{Date: date, Nanos: 1_000_000_000}, "invalid clock"},
+		{&nepalipb.NepaliTime{Date: date, TimeZone: "Mars/Olympus"}, "unknown time zone Mars/Olympus"},
+		{&nepalipb.NepaliTime{Date: date, UtcOffsetSeconds: proto.Int32(24 * 60 * 60)}, "invalid UTC offset"},
+	}
+
+	for _, tc := range testCases {
+		got, err := tc.msg.AsNepaliTime()
+		assert.Nil(t, got)
+		assert.EqualError(t, err, tc.err)
+	}
+}
+
+func TestTimestampConversion(t *testing.T) {
+	nepaliTime, _ := nepalitime.Date(2079, 10, 14, 5, 45, 0, 0)
+
+	ts := nepalipb.NewTimestamp(nepaliTime)
+	assert.Equal(t, time.Date(2023, 1, 28, 0, 0, 0, 0, time.UTC), ts.AsTime())
+
+	got, err := nepalipb.FromTimestamp(ts)
+	assert.NoError(t, err)
+	assert.Equal(t, nepaliTime.String(), got.String())
+
+	got, err = nepalipb.FromTimestampIn(ts, time.UTC)
+	assert.NoError(t, err)
+	assert.Equal(t, "2079-10-14 00:00:00", got.String())
+
+	ts, err = nepalipb.NewNepaliTime(nepaliTime).AsTimestamp()
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2023, 1, 28, 0, 0, 0, 0, time.UTC), ts.AsTime())
+}
+
+func TestFromTimestampInvalid(t *testing.T) {
+	_, err := nepalipb.FromTimestamp(nil)
+	assert.EqualError(t, err, "missing timestamp")
+
+	_, err = nepalipb.FromTimestamp(&timestamppb.Timestamp{Nanos: -1})
+	assert.Error(t, err)
+
+	_, err = nepalipb.FromTimestamp(timestamppb.New(time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC)))
+	assert.Error(t, err)
+}
+
+func TestFiscalYear(t *testing.T) {
+	fiscalYear, err := nepalipb.NewFiscalYear(2080)
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(&nepalipb.FiscalYear{
+		StartYear: 2080,
+		Start:     &nepalipb.NepaliDate{Year: 2080, Month: 4, Day: 1},
+		End:       &nepalipb.NepaliDate{Year: 2081, Month: 3, Day: 31},
+	}, fiscalYear), fiscalYear.String())
+
+	// Jestha is in the fiscal year started in the previous year
+	date, _ := nepalitime.NewDate(2081, 2, 10)
+	got, err := nepalipb.FiscalYearOf(date)
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(fiscalYear, got))
+
+	dates, err := fiscalYear.AsRange()
+	assert.NoError(t, err)
+	assert.Equal(t, 365, dates.Len())
+}
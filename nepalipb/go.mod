@@ -0,0 +1,23 @@
+module github.com/opensource-nepal/go-nepali/nepalipb
+
+go 1.24.0
+
+require (
+	github.com/opensource-nepal/go-nepali v0.0.0-00010101000000-000000000000
+	github.com/stretchr/testify v1.10.0
+	google.golang.org/grpc v1.79.3
+	google.golang.org/protobuf v1.36.11
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	golang.org/x/net v0.48.0 // indirect
+	golang.org/x/sys v0.39.0 // indirect
+	golang.org/x/text v0.32.0 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20251202230838-ff82c1b0f217 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
+
+// the nepalipb module is developed along with the root module
+replace github.com/opensource-nepal/go-nepali => ../
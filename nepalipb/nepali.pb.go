@@ -0,0 +1,813 @@
+// Protocol buffer messages and service of the nepali (BS) dates.
+//
+// The Go code is generated with go generate in the nepalipb directory (module).
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: nepalipb/nepali.proto
+
+package nepalipb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// NepaliDate is a date of the nepali (Bikram Sambat) calendar.
+type NepaliDate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Year          int32                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`   // eg. 2080
+	Month         int32                  `protobuf:"varint,2,opt,name=month,proto3" json:"month,omitempty"` // 1 (Baisakh) to 12 (Chaitra)
+	Day           int32                  `protobuf:"varint,3,opt,name=day,proto3" json:"day,omitempty"`     // 1 to 32
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NepaliDate) Reset() {
+	*x = NepaliDate{}
+	mi := &file_nepalipb_nepali_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NepaliDate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NepaliDate) ProtoMessage() {}
+
+func (x *NepaliDate) ProtoReflect() protoreflect.Message {
+	mi := &file_nepalipb_nepali_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NepaliDate.ProtoReflect.Descriptor instead.
+func (*NepaliDate) Descriptor() ([]byte, []int) {
+	return file_nepalipb_nepali_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *NepaliDate) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *NepaliDate) GetMonth() int32 {
+	if x != nil {
+		return x.Month
+	}
+	return 0
+}
+
+func (x *NepaliDate) GetDay() int32 {
+	if x != nil {
+		return x.Day
+	}
+	return 0
+}
+
+// NepaliTime is a wall time of the nepali calendar in a time zone.
+type NepaliTime struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Date   *NepaliDate            `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Hour   int32                  `protobuf:"varint,2,opt,name=hour,proto3" json:"hour,omitempty"`
+	Minute int32                  `protobuf:"varint,3,opt,name=minute,proto3" json:"minute,omitempty"`
+	Second int32                  `protobuf:"varint,4,opt,name=second,proto3" json:"second,omitempty"`
+	Nanos  int32                  `protobuf:"varint,5,opt,name=nanos,proto3" json:"nanos,omitempty"`
+	// IANA time zone, eg. "Asia/Kathmandu" (the default if empty)
+	TimeZone string `protobuf:"bytes,6,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	// UTC offset of the time in seconds, eg. 20700 for +0545.
+	// It is used when time_zone is empty or isn't an IANA time zone,
+	// eg. for the times parsed with %z.
+	UtcOffsetSeconds *int32 `protobuf:"varint,7,opt,name=utc_offset_seconds,json=utcOffsetSeconds,proto3,oneof" json:"utc_offset_seconds,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *NepaliTime) Reset() {
+	*x = NepaliTime{}
+	mi := &file_nepalipb_nepali_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NepaliTime) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NepaliTime) ProtoMessage() {}
+
+func (x *NepaliTime) ProtoReflect() protoreflect.Message {
+	mi := &file_nepalipb_nepali_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NepaliTime.ProtoReflect.Descriptor instead.
+func (*NepaliTime) Descriptor() ([]byte, []int) {
+	return file_nepalipb_nepali_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *NepaliTime) GetDate() *NepaliDate {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *NepaliTime) GetHour() int32 {
+	if x != nil {
+		return x.Hour
+	}
+	return 0
+}
+
+func (x *NepaliTime) GetMinute() int32 {
+	if x != nil {
+		return x.Minute
+	}
+	return 0
+}
+
+func (x *NepaliTime) GetSecond() int32 {
+	if x != nil {
+		return x.Second
+	}
+	return 0
+}
+
+func (x *NepaliTime) GetNanos() int32 {
+	if x != nil {
+		return x.Nanos
+	}
+	return 0
+}
+
+func (x *NepaliTime) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *NepaliTime) GetUtcOffsetSeconds() int32 {
+	if x != nil && x.UtcOffsetSeconds != nil {
+		return *x.UtcOffsetSeconds
+	}
+	return 0
+}
+
+// FiscalYear is the nepali fiscal year from Shrawan 1 to the end of Asar,
+// eg. FY 2080/81 is from 2080-04-01 to 2081-03-31.
+type FiscalYear struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartYear     int32                  `protobuf:"varint,1,opt,name=start_year,json=startYear,proto3" json:"start_year,omitempty"` // eg. 2080 for FY 2080/81
+	Start         *NepaliDate            `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	End           *NepaliDate            `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"` // inclusive
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FiscalYear) Reset() {
+	*x = FiscalYear{}
+	mi := &file_nepalipb_nepali_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FiscalYear) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FiscalYear) ProtoMessage() {}
+
+func (x *FiscalYear) ProtoReflect() protoreflect.Message {
+	mi := &file_nepalipb_nepali_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FiscalYear.ProtoReflect.Descriptor instead.
+func (*FiscalYear) Descriptor() ([]byte, []int) {
+	return file_nepalipb_nepali_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *FiscalYear) GetStartYear() int32 {
+	if x != nil {
+		return x.StartYear
+	}
+	return 0
+}
+
+func (x *FiscalYear) GetStart() *NepaliDate {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *FiscalYear) GetEnd() *NepaliDate {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+type ToNepaliRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Time  *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	// IANA time zone of the result, "Asia/Kathmandu" if empty
+	TimeZone      string `protobuf:"bytes,2,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToNepaliRequest) Reset() {
+	*x = ToNepaliRequest{}
+	mi := &file_nepalipb_nepali_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToNepaliRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToNepaliRequest) ProtoMessage() {}
+
+func (x *ToNepaliRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nepalipb_nepali_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToNepaliRequest.ProtoReflect.Descriptor instead.
+func (*ToNepaliRequest) Descriptor() ([]byte, []int) {
+	return file_nepalipb_nepali_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ToNepaliRequest) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *ToNepaliRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+type ToNepaliResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Time          *NepaliTime            `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToNepaliResponse) Reset() {
+	*x = ToNepaliResponse{}
+	mi := &file_nepalipb_nepali_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToNepaliResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToNepaliResponse) ProtoMessage() {}
+
+func (x *ToNepaliResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nepalipb_nepali_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToNepaliResponse.ProtoReflect.Descriptor instead.
+func (*ToNepaliResponse) Descriptor() ([]byte, []int) {
+	return file_nepalipb_nepali_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ToNepaliResponse) GetTime() *NepaliTime {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+type ToEnglishRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Time          *NepaliTime            `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToEnglishRequest) Reset() {
+	*x = ToEnglishRequest{}
+	mi := &file_nepalipb_nepali_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToEnglishRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToEnglishRequest) ProtoMessage() {}
+
+func (x *ToEnglishRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nepalipb_nepali_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToEnglishRequest.ProtoReflect.Descriptor instead.
+func (*ToEnglishRequest) Descriptor() ([]byte, []int) {
+	return file_nepalipb_nepali_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ToEnglishRequest) GetTime() *NepaliTime {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+type ToEnglishResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Time          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToEnglishResponse) Reset() {
+	*x = ToEnglishResponse{}
+	mi := &file_nepalipb_nepali_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToEnglishResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToEnglishResponse) ProtoMessage() {}
+
+func (x *ToEnglishResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nepalipb_nepali_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToEnglishResponse.ProtoReflect.Descriptor instead.
+func (*ToEnglishResponse) Descriptor() ([]byte, []int) {
+	return file_nepalipb_nepali_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ToEnglishResponse) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+type FormatRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Time          *NepaliTime            `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	Format        string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FormatRequest) Reset() {
+	*x = FormatRequest{}
+	mi := &file_nepalipb_nepali_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FormatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FormatRequest) ProtoMessage() {}
+
+func (x *FormatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nepalipb_nepali_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FormatRequest.ProtoReflect.Descriptor instead.
+func (*FormatRequest) Descriptor() ([]byte, []int) {
+	return file_nepalipb_nepali_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *FormatRequest) GetTime() *NepaliTime {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *FormatRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type FormatResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Formatted     string                 `protobuf:"bytes,1,opt,name=formatted,proto3" json:"formatted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FormatResponse) Reset() {
+	*x = FormatResponse{}
+	mi := &file_nepalipb_nepali_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FormatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FormatResponse) ProtoMessage() {}
+
+func (x *FormatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nepalipb_nepali_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FormatResponse.ProtoReflect.Descriptor instead.
+func (*FormatResponse) Descriptor() ([]byte, []int) {
+	return file_nepalipb_nepali_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *FormatResponse) GetFormatted() string {
+	if x != nil {
+		return x.Formatted
+	}
+	return ""
+}
+
+type ParseRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Value  string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Format string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	// IANA time zone of the parsed time, "Asia/Kathmandu" if empty
+	TimeZone      string `protobuf:"bytes,3,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ParseRequest) Reset() {
+	*x = ParseRequest{}
+	mi := &file_nepalipb_nepali_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParseRequest) ProtoMessage() {}
+
+func (x *ParseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nepalipb_nepali_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParseRequest.ProtoReflect.Descriptor instead.
+func (*ParseRequest) Descriptor() ([]byte, []int) {
+	return file_nepalipb_nepali_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ParseRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *ParseRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ParseRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+type ParseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Time          *NepaliTime            `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ParseResponse) Reset() {
+	*x = ParseResponse{}
+	mi := &file_nepalipb_nepali_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParseResponse) ProtoMessage() {}
+
+func (x *ParseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nepalipb_nepali_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParseResponse.ProtoReflect.Descriptor instead.
+func (*ParseResponse) Descriptor() ([]byte, []int) {
+	return file_nepalipb_nepali_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ParseResponse) GetTime() *NepaliTime {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+type GetFiscalYearRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          *NepaliDate            `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFiscalYearRequest) Reset() {
+	*x = GetFiscalYearRequest{}
+	mi := &file_nepalipb_nepali_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFiscalYearRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFiscalYearRequest) ProtoMessage() {}
+
+func (x *GetFiscalYearRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nepalipb_nepali_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFiscalYearRequest.ProtoReflect.Descriptor instead.
+func (*GetFiscalYearRequest) Descriptor() ([]byte, []int) {
+	return file_nepalipb_nepali_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetFiscalYearRequest) GetDate() *NepaliDate {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+var File_nepalipb_nepali_proto protoreflect.FileDescriptor
+
+const file_nepalipb_nepali_proto_rawDesc = "" +
+	"\n" +
+	"\x15nepalipb/nepali.proto\x12\tnepali.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"H\n" +
+	"\n" +
+	"NepaliDate\x12\x12\n" +
+	"\x04year\x18\x01 \x01(\x05R\x04year\x12\x14\n" +
+	"\x05month\x18\x02 \x01(\x05R\x05month\x12\x10\n" +
+	"\x03day\x18\x03 \x01(\x05R\x03day\"\xf8\x01\n" +
+	"\n" +
+	"NepaliTime\x12)\n" +
+	"\x04date\x18\x01 \x01(\v2\x15.nepali.v1.NepaliDateR\x04date\x12\x12\n" +
+	"\x04hour\x18\x02 \x01(\x05R\x04hour\x12\x16\n" +
+	"\x06minute\x18\x03 \x01(\x05R\x06minute\x12\x16\n" +
+	"\x06second\x18\x04 \x01(\x05R\x06second\x12\x14\n" +
+	"\x05nanos\x18\x05 \x01(\x05R\x05nanos\x12\x1b\n" +
+	"\ttime_zone\x18\x06 \x01(\tR\btimeZone\x121\n" +
+	"\x12utc_offset_seconds\x18\a \x01(\x05H\x00R\x10utcOffsetSeconds\x88\x01\x01B\x15\n" +
+	"\x13_utc_offset_seconds\"\x81\x01\n" +
+	"\n" +
+	"FiscalYear\x12\x1d\n" +
+	"\n" +
+	"start_year\x18\x01 \x01(\x05R\tstartYear\x12+\n" +
+	"\x05start\x18\x02 \x01(\v2\x15.nepali.v1.NepaliDateR\x05start\x12'\n" +
+	"\x03end\x18\x03 \x01(\v2\x15.nepali.v1.NepaliDateR\x03end\"^\n" +
+	"\x0fToNepaliRequest\x12.\n" +
+	"\x04time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12\x1b\n" +
+	"\ttime_zone\x18\x02 \x01(\tR\btimeZone\"=\n" +
+	"\x10ToNepaliResponse\x12)\n" +
+	"\x04time\x18\x01 \x01(\v2\x15.nepali.v1.NepaliTimeR\x04time\"=\n" +
+	"\x10ToEnglishRequest\x12)\n" +
+	"\x04time\x18\x01 \x01(\v2\x15.nepali.v1.NepaliTimeR\x04time\"C\n" +
+	"\x11ToEnglishResponse\x12.\n" +
+	"\x04time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\"R\n" +
+	"\rFormatRequest\x12)\n" +
+	"\x04time\x18\x01 \x01(\v2\x15.nepali.v1.NepaliTimeR\x04time\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\".\n" +
+	"\x0eFormatResponse\x12\x1c\n" +
+	"\tformatted\x18\x01 \x01(\tR\tformatted\"Y\n" +
+	"\fParseRequest\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\x12\x1b\n" +
+	"\ttime_zone\x18\x03 \x01(\tR\btimeZone\":\n" +
+	"\rParseResponse\x12)\n" +
+	"\x04time\x18\x01 \x01(\v2\x15.nepali.v1.NepaliTimeR\x04time\"A\n" +
+	"\x14GetFiscalYearRequest\x12)\n" +
+	"\x04date\x18\x01 \x01(\v2\x15.nepali.v1.NepaliDateR\x04date2\xe4\x02\n" +
+	"\x11NepaliDateService\x12C\n" +
+	"\bToNepali\x12\x1a.nepali.v1.ToNepaliRequest\x1a\x1b.nepali.v1.ToNepaliResponse\x12F\n" +
+	"\tToEnglish\x12\x1b.nepali.v1.ToEnglishRequest\x1a\x1c.nepali.v1.ToEnglishResponse\x12=\n" +
+	"\x06Format\x12\x18.nepali.v1.FormatRequest\x1a\x19.nepali.v1.FormatResponse\x12:\n" +
+	"\x05Parse\x12\x17.nepali.v1.ParseRequest\x1a\x18.nepali.v1.ParseResponse\x12G\n" +
+	"\rGetFiscalYear\x12\x1f.nepali.v1.GetFiscalYearRequest\x1a\x15.nepali.v1.FiscalYearB0Z.github.com/opensource-nepal/go-nepali/nepalipbb\x06proto3"
+
+var (
+	file_nepalipb_nepali_proto_rawDescOnce sync.Once
+	file_nepalipb_nepali_proto_rawDescData []byte
+)
+
+func file_nepalipb_nepali_proto_rawDescGZIP() []byte {
+	file_nepalipb_nepali_proto_rawDescOnce.Do(func() {
+		file_nepalipb_nepali_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_nepalipb_nepali_proto_rawDesc), len(file_nepalipb_nepali_proto_rawDesc)))
+	})
+	return file_nepalipb_nepali_proto_rawDescData
+}
+
+var file_nepalipb_nepali_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_nepalipb_nepali_proto_goTypes = []any{
+	(*NepaliDate)(nil),            // 0: nepali.v1.NepaliDate
+	(*NepaliTime)(nil),            // 1: nepali.v1.NepaliTime
+	(*FiscalYear)(nil),            // 2: nepali.v1.FiscalYear
+	(*ToNepaliRequest)(nil),       // 3: nepali.v1.ToNepaliRequest
+	(*ToNepaliResponse)(nil),      // 4: nepali.v1.ToNepaliResponse
+	(*ToEnglishRequest)(nil),      // 5: nepali.v1.ToEnglishRequest
+	(*ToEnglishResponse)(nil),     // 6: nepali.v1.ToEnglishResponse
+	(*FormatRequest)(nil),         // 7: nepali.v1.FormatRequest
+	(*FormatResponse)(nil),        // 8: nepali.v1.FormatResponse
+	(*ParseRequest)(nil),          // 9: nepali.v1.ParseRequest
+	(*ParseResponse)(nil),         // 10: nepali.v1.ParseResponse
+	(*GetFiscalYearRequest)(nil),  // 11: nepali.v1.GetFiscalYearRequest
+	(*timestamppb.Timestamp)(nil), // 12: google.protobuf.Timestamp
+}
+var file_nepalipb_nepali_proto_depIdxs = []int32{
+	0,  // 0: nepali.v1.NepaliTime.date:type_name -> nepali.v1.NepaliDate
+	0,  // 1: nepali.v1.FiscalYear.start:type_name -> nepali.v1.NepaliDate
+	0,  // 2: nepali.v1.FiscalYear.end:type_name -> nepali.v1.NepaliDate
+	12, // 3: nepali.v1.ToNepaliRequest.time:type_name -> google.protobuf.Timestamp
+	1,  // 4: nepali.v1.ToNepaliResponse.time:type_name -> nepali.v1.NepaliTime
+	1,  // 5: nepali.v1.ToEnglishRequest.time:type_name -> nepali.v1.NepaliTime
+	12, // 6: nepali.v1.ToEnglishResponse.time:type_name -> google.protobuf.Timestamp
+	1,  // 7: nepali.v1.FormatRequest.time:type_name -> nepali.v1.NepaliTime
+	1,  // 8: nepali.v1.ParseResponse.time:type_name -> nepali.v1.NepaliTime
+	0,  // 9: nepali.v1.GetFiscalYearRequest.date:type_name -> nepali.v1.NepaliDate
+	3,  // 10: nepali.v1.NepaliDateService.ToNepali:input_type -> nepali.v1.ToNepaliRequest
+	5,  // 11: nepali.v1.NepaliDateService.ToEnglish:input_type -> nepali.v1.ToEnglishRequest
+	7,  // 12: nepali.v1.NepaliDateService.Format:input_type -> nepali.v1.FormatRequest
+	9,  // 13: nepali.v1.NepaliDateService.Parse:input_type -> nepali.v1.ParseRequest
+	11, // 14: nepali.v1.NepaliDateService.GetFiscalYear:input_type -> nepali.v1.GetFiscalYearRequest
+	4,  // 15: nepali.v1.NepaliDateService.ToNepali:output_type -> nepali.v1.ToNepaliResponse
+	6,  // 16: nepali.v1.NepaliDateService.ToEnglish:output_type -> nepali.v1.ToEnglishResponse
+	8,  // 17: nepali.v1.NepaliDateService.Format:output_type -> nepali.v1.FormatResponse
+	10, // 18: nepali.v1.NepaliDateService.Parse:output_type -> nepali.v1.ParseResponse
+	2,  // 19: nepali.v1.NepaliDateService.GetFiscalYear:output_type -> nepali.v1.FiscalYear
+	15, // [15:20] is the sub-list for method output_type
+	10, // [10:15] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_nepalipb_nepali_proto_init() }
+func file_nepalipb_nepali_proto_init() {
+	if File_nepalipb_nepali_proto != nil {
+		return
+	}
+	file_nepalipb_nepali_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_nepalipb_nepali_proto_rawDesc), len(file_nepalipb_nepali_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_nepalipb_nepali_proto_goTypes,
+		DependencyIndexes: file_nepalipb_nepali_proto_depIdxs,
+		MessageInfos:      file_nepalipb_nepali_proto_msgTypes,
+	}.Build()
+	File_nepalipb_nepali_proto = out.File
+	file_nepalipb_nepali_proto_goTypes = nil
+	file_nepalipb_nepali_proto_depIdxs = nil
+}
@@ -0,0 +1,291 @@
+// Protocol buffer messages and service of the nepali (BS) dates.
+//
+// The Go code is generated with go generate in the nepalipb directory (module).
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: nepalipb/nepali.proto
+
+package nepalipb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	NepaliDateService_ToNepali_FullMethodName      = "/nepali.v1.NepaliDateService/ToNepali"
+	NepaliDateService_ToEnglish_FullMethodName     = "/nepali.v1.NepaliDateService/ToEnglish"
+	NepaliDateService_Format_FullMethodName        = "/nepali.v1.NepaliDateService/Format"
+	NepaliDateService_Parse_FullMethodName         = "/nepali.v1.NepaliDateService/Parse"
+	NepaliDateService_GetFiscalYear_FullMethodName = "/nepali.v1.NepaliDateService/GetFiscalYear"
+)
+
+// NepaliDateServiceClient is the client API for NepaliDateService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// NepaliDateService converts, formats and parses the nepali dates.
+type NepaliDateServiceClient interface {
+	// ToNepali converts the time into the nepali time.
+	ToNepali(ctx context.Context, in *ToNepaliRequest, opts ...grpc.CallOption) (*ToNepaliResponse, error)
+	// ToEnglish converts the nepali time into the time.
+	ToEnglish(ctx context.Context, in *ToEnglishRequest, opts ...grpc.CallOption) (*ToEnglishResponse, error)
+	// Format formats the nepali time with the date directives, eg. "%d %B %Y".
+	Format(ctx context.Context, in *FormatRequest, opts ...grpc.CallOption) (*FormatResponse, error)
+	// Parse parses the nepali time with the date directives.
+	Parse(ctx context.Context, in *ParseRequest, opts ...grpc.CallOption) (*ParseResponse, error)
+	// GetFiscalYear returns the fiscal year of the nepali date.
+	GetFiscalYear(ctx context.Context, in *GetFiscalYearRequest, opts ...grpc.CallOption) (*FiscalYear, error)
+}
+
+type nepaliDateServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewNepaliDateServiceClient(cc grpc.ClientConnInterface) NepaliDateServiceClient {
+	return &nepaliDateServiceClient{cc}
+}
+
+func (c *nepaliDateServiceClient) ToNepali(ctx context.Context, in *ToNepaliRequest, opts ...grpc.CallOption) (*ToNepaliResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ToNepaliResponse)
+	err := c.cc.Invoke(ctx, NepaliDateService_ToNepali_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nepaliDateServiceClient) ToEnglish(ctx context.Context, in *ToEnglishRequest, opts ...grpc.CallOption) (*ToEnglishResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ToEnglishResponse)
+	err := c.cc.Invoke(ctx, NepaliDateService_ToEnglish_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nepaliDateServiceClient) Format(ctx context.Context, in *FormatRequest, opts ...grpc.CallOption) (*FormatResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FormatResponse)
+	err := c.cc.Invoke(ctx, NepaliDateService_Format_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nepaliDateServiceClient) Parse(ctx context.Context, in *ParseRequest, opts ...grpc.CallOption) (*ParseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ParseResponse)
+	err := c.cc.Invoke(ctx, NepaliDateService_Parse_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nepaliDateServiceClient) GetFiscalYear(ctx context.Context, in *GetFiscalYearRequest, opts ...grpc.CallOption) (*FiscalYear, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FiscalYear)
+	err := c.cc.Invoke(ctx, NepaliDateService_GetFiscalYear_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NepaliDateServiceServer is the server API for NepaliDateService service.
+// All implementations must embed UnimplementedNepaliDateServiceServer
+// for forward compatibility.
+//
+// NepaliDateService converts, formats and parses the nepali dates.
+type NepaliDateServiceServer interface {
+	// ToNepali converts the time into the nepali time.
+	ToNepali(context.Context, *ToNepaliRequest) (*ToNepaliResponse, error)
+	// ToEnglish converts the nepali time into the time.
+	ToEnglish(context.Context, *ToEnglishRequest) (*ToEnglishResponse, error)
+	// Format formats the nepali time with the date directives, eg. "%d %B %Y".
+	Format(context.Context, *FormatRequest) (*FormatResponse, error)
+	// Parse parses the nepali time with the date directives.
+	Parse(context.Context, *ParseRequest) (*ParseResponse, error)
+	// GetFiscalYear returns the fiscal year of the nepali date.
+	GetFiscalYear(context.Context, *GetFiscalYearRequest) (*FiscalYear, error)
+	mustEmbedUnimplementedNepaliDateServiceServer()
+}
+
+// UnimplementedNepaliDateServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedNepaliDateServiceServer struct{}
+
+func (UnimplementedNepaliDateServiceServer) ToNepali(context.Context, *ToNepaliRequest) (*ToNepaliResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ToNepali not implemented")
+}
+func (UnimplementedNepaliDateServiceServer) ToEnglish(context.Context, *ToEnglishRequest) (*ToEnglishResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ToEnglish not implemented")
+}
+func (UnimplementedNepaliDateServiceServer) Format(context.Context, *FormatRequest) (*FormatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Format not implemented")
+}
+func (UnimplementedNepaliDateServiceServer) Parse(context.Context, *ParseRequest) (*ParseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Parse not implemented")
+}
+func (UnimplementedNepaliDateServiceServer) GetFiscalYear(context.Context, *GetFiscalYearRequest) (*FiscalYear, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFiscalYear not implemented")
+}
+func (UnimplementedNepaliDateServiceServer) mustEmbedUnimplementedNepaliDateServiceServer() {}
+func (UnimplementedNepaliDateServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeNepaliDateServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to NepaliDateServiceServer will
+// result in compilation errors.
+type UnsafeNepaliDateServiceServer interface {
+	mustEmbedUnimplementedNepaliDateServiceServer()
+}
+
+func RegisterNepaliDateServiceServer(s grpc.ServiceRegistrar, srv NepaliDateServiceServer) {
+	// If the following call pancis, it indicates UnimplementedNepaliDateServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&NepaliDateService_ServiceDesc, srv)
+}
+
+func _NepaliDateService_ToNepali_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ToNepaliRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NepaliDateServiceServer).ToNepali(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NepaliDateService_ToNepali_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NepaliDateServiceServer).ToNepali(ctx, req.(*ToNepaliRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NepaliDateService_ToEnglish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ToEnglishRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NepaliDateServiceServer).ToEnglish(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NepaliDateService_ToEnglish_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NepaliDateServiceServer).ToEnglish(ctx, req.(*ToEnglishRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NepaliDateService_Format_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FormatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NepaliDateServiceServer).Format(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NepaliDateService_Format_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NepaliDateServiceServer).Format(ctx, req.(*FormatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NepaliDateService_Parse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NepaliDateServiceServer).Parse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NepaliDateService_Parse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NepaliDateServiceServer).Parse(ctx, req.(*ParseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NepaliDateService_GetFiscalYear_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFiscalYearRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NepaliDateServiceServer).GetFiscalYear(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NepaliDateService_GetFiscalYear_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NepaliDateServiceServer).GetFiscalYear(ctx, req.(*GetFiscalYearRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// NepaliDateService_ServiceDesc is the grpc.ServiceDesc for NepaliDateService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var NepaliDateService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "nepali.v1.NepaliDateService",
+	HandlerType: (*NepaliDateServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ToNepali",
+			Handler:    _NepaliDateService_ToNepali_Handler,
+		},
+		{
+			MethodName: "ToEnglish",
+			Handler:    _NepaliDateService_ToEnglish_Handler,
+		},
+		{
+			MethodName: "Format",
+			Handler:    _NepaliDateService_Format_Handler,
+		},
+		{
+			MethodName: "Parse",
+			Handler:    _NepaliDateService_Parse_Handler,
+		},
+		{
+			MethodName: "GetFiscalYear",
+			Handler:    _NepaliDateService_GetFiscalYear_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "nepalipb/nepali.proto",
+}
@@ -0,0 +1,88 @@
+package nepalipb
+
+import (
+	"context"
+	"errors"
+
+	"github.com/opensource-nepal/go-nepali/dateConverter"
+	"github.com/opensource-nepal/go-nepali/nepalitime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Server is the reference implementation of NepaliDateServiceServer.
+//
+// USAGE:
+//
+//	grpcServer := grpc.NewServer()
+//	nepalipb.RegisterNepaliDateServiceServer(grpcServer, nepalipb.NewServer())
+type Server struct {
+	UnimplementedNepaliDateServiceServer
+}
+
+// NewServer returns the reference implementation of NepaliDateServiceServer.
+func NewServer() *Server {
+	return &Server{}
+}
+
+func (s *Server) ToNepali(ctx context.Context, req *ToNepaliRequest) (*ToNepaliResponse, error) {
+	loc, err := LoadLocation(req.GetTimeZone())
+	if err != nil {
+		return nil, statusError("time_zone", err)
+	}
+	nepaliTime, err := FromTimestampIn(req.GetTime(), loc)
+	if err != nil {
+		return nil, statusError("time", err)
+	}
+	return &ToNepaliResponse{Time: NewNepaliTime(nepaliTime)}, nil
+}
+
+func (s *Server) ToEnglish(ctx context.Context, req *ToEnglishRequest) (*ToEnglishResponse, error) {
+	ts, err := req.GetTime().AsTimestamp()
+	if err != nil {
+		return nil, statusError("time", err)
+	}
+	return &ToEnglishResponse{Time: ts}, nil
+}
+
+func (s *Server) Format(ctx context.Context, req *FormatRequest) (*FormatResponse, error) {
+	nepaliTime, err := req.GetTime().AsNepaliTime()
+	if err != nil {
+		return nil, statusError("time", err)
+	}
+	return &FormatResponse{Formatted: nepaliTime.Format(req.GetFormat())}, nil
+}
+
+func (s *Server) Parse(ctx context.Context, req *ParseRequest) (*ParseResponse, error) {
+	loc, err := LoadLocation(req.GetTimeZone())
+	if err != nil {
+		return nil, statusError("time_zone", err)
+	}
+	nepaliTime, err := nepalitime.ParseInLocation(req.GetValue(), req.GetFormat(), loc)
+	if err != nil {
+		return nil, statusError("value", err)
+	}
+	return &ParseResponse{Time: NewNepaliTime(nepaliTime)}, nil
+}
+
+func (s *Server) GetFiscalYear(ctx context.Context, req *GetFiscalYearRequest) (*FiscalYear, error) {
+	date, err := req.GetDate().AsNepaliDate()
+	if err != nil {
+		return nil, statusError("date", err)
+	}
+	fiscalYear, err := FiscalYearOf(date)
+	if err != nil {
+		return nil, statusError("date", err)
+	}
+	return fiscalYear, nil
+}
+
+// returns the status error of the invalid field of the request,
+// OutOfRange for the dates outside the supported range, else InvalidArgument
+func statusError(field string, err error) error {
+	code := codes.InvalidArgument
+	if errors.Is(err, dateConverter.ErrOutOfRange) {
+		code = codes.OutOfRange
+	}
+	return status.Errorf(code, "invalid %s: %v", field, err)
+}
@@ -0,0 +1,156 @@
+package nepalipb_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/opensource-nepal/go-nepali/nepalipb"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// starts the reference server in-process, returns the client connected to it
+func newTestClient(t *testing.T) nepalipb.NepaliDateServiceClient {
+	t.Helper()
+
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	nepalipb.RegisterNepaliDateServiceServer(server, nepalipb.NewServer())
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	return nepalipb.NewNepaliDateServiceClient(conn)
+}
+
+var magh14 = &nepalipb.NepaliDate{Year: 2079, Month: 10, Day: 14}
+
+func TestServerToNepali(t *testing.T) {
+	client := newTestClient(t)
+
+	resp, err := client.ToNepali(context.Background(), &nepalipb.ToNepaliRequest{
+		Time: timestamppb.New(time.Date(2023, 1, 28, 4, 15, 0, 0, time.UTC)),
+	})
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(&nepalipb.NepaliTime{Date: magh14, Hour: 10, TimeZone: "Asia/Kathmandu", UtcOffsetSeconds: proto.Int32(20700)}, resp.GetTime()), resp.String())
+
+	resp, err = client.ToNepali(context.Background(), &nepalipb.ToNepaliRequest{
+		Time:     timestamppb.New(time.Date(2023, 1, 28, 4, 15, 0, 0, time.UTC)),
+		TimeZone: "UTC",
+	})
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(&nepalipb.NepaliTime{Date: magh14, Hour: 4, Minute: 15, TimeZone: "UTC", UtcOffsetSeconds: proto.Int32(0)}, resp.GetTime()), resp.String())
+}
+
+func TestServerToEnglish(t *testing.T) {
+	client := newTestClient(t)
+
+	resp, err := client.ToEnglish(context.Background(), &nepalipb.ToEnglishRequest{
+		Time: &nepalipb.NepaliTime{Date: magh14, Hour: 10},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2023, 1, 28, 4, 15, 0, 0, time.UTC), resp.GetTime().AsTime())
+}
+
+func TestServerFormat(t *testing.T) {
+	client := newTestClient(t)
+
+	resp, err := client.Format(context.Background(), &nepalipb.FormatRequest{
+		Time:   &nepalipb.NepaliTime{Date: magh14},
+		Format: "%d %B %Y, %A",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "14 Magh 2079, Saturday", resp.GetFormatted())
+}
+
+func TestServerParse(t *testing.T) {
+	client := newTestClient(t)
+
+	resp, err := client.Parse(context.Background(), &nepalipb.ParseRequest{
+		Value:  "14 Magh 2079 16:23",
+		Format: "%d %B %Y %H:%M",
+	})
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(&nepalipb.NepaliTime{Date: magh14, Hour: 16, Minute: 23, TimeZone: "Asia/Kathmandu", UtcOffsetSeconds: proto.Int32(20700)}, resp.GetTime()), resp.String())
+
+	// the parsed UTC offset is kept
+	resp, err = client.Parse(context.Background(), &nepalipb.ParseRequest{
+		Value:  "2079-10-14 10:00 +0530",
+		Format: "%Y-%m-%d %H:%M %z",
+	})
+	assert.NoError(t, err)
+	got, err := resp.GetTime().AsNepaliTime()
+	assert.NoError(t, err)
+	assert.Equal(t, "2079-10-14 10:00 +0530", got.Format("%Y-%m-%d %H:%M %z"))
+}
+
+func TestServerGetFiscalYear(t *testing.T) {
+	client := newTestClient(t)
+
+	resp, err := client.GetFiscalYear(context.Background(), &nepalipb.GetFiscalYearRequest{Date: magh14})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2079), resp.GetStartYear())
+	assert.True(t, proto.Equal(&nepalipb.NepaliDate{Year: 2079, Month: 4, Day: 1}, resp.GetStart()))
+}
+
+func TestServerErrors(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+
+	testCases := []struct {
+		name string
+		call func() error
+		code codes.Code
+	}{
+		{"missing time", func() error {
+			_, err := client.ToNepali(ctx, &nepalipb.ToNepaliRequest{})
+			return err
+		}, codes.InvalidArgument},
+		{"time out of range", func() error {
+			_, err := client.ToNepali(ctx, &nepalipb.ToNepaliRequest{Time: timestamppb.New(time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC))})
+			return err
+		}, codes.OutOfRange},
+		{"unknown time zone", func() error {
+			_, err := client.ToNepali(ctx, &nepalipb.ToNepaliRequest{Time: timestamppb.Now(), TimeZone: "Mars/Olympus"})
+			return err
+		}, codes.InvalidArgument},
+		{"invalid clock", func() error {
+			_, err := client.ToEnglish(ctx, &nepalipb.ToEnglishRequest{Time: &nepalipb.NepaliTime{Date: magh14, Hour: 25}})
+			return err
+		}, codes.InvalidArgument},
+		{"date out of range", func() error {
+			_, err := client.Format(ctx, &nepalipb.FormatRequest{Time: &nepalipb.NepaliTime{Date: &nepalipb.NepaliDate{Year: 1900, Month: 1, Day: 1}}})
+			return err
+		}, codes.OutOfRange},
+		{"invalid value", func() error {
+			_, err := client.Parse(ctx, &nepalipb.ParseRequest{Value: "hello", Format: "%Y-%m-%d"})
+			return err
+		}, codes.InvalidArgument},
+		{"missing date", func() error {
+			_, err := client.GetFiscalYear(ctx, &nepalipb.GetFiscalYearRequest{})
+			return err
+		}, codes.InvalidArgument},
+	}
+
+	for _, tc := range testCases {
+		assert.Equal(t, tc.code, status.Code(tc.call()), tc.name)
+	}
+}
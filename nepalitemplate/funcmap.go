@@ -0,0 +1,219 @@
+// Package nepalitemplate
+// This package contains the template functions of the nepali dates and numbers for text/template and html/template,
+// like the Django templatetags of the python package (https://github.com/opensource-nepal/py-nepali).
+//
+// USAGE:
+//
+//	tmpl := template.New("receipt").Funcs(nepalitemplate.FuncMap())
+//
+//	{{ nepaliDate .CreatedAt "%d %B %Y" }}   => 14 Magh 2079
+//	{{ nepaliDateNe .CreatedAt "%d %B %Y" }} => १४ माघ २०७९
+//	{{ devanagari .Amount }}                 => १२५०
+//	{{ nepaliCommaNe .Amount }}              => १,२३,४५६
+//	{{ bsNow.Year }}                         => 2079
+package nepalitemplate
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/opensource-nepal/go-nepali/constants"
+	"github.com/opensource-nepal/go-nepali/nepalinumber"
+	"github.com/opensource-nepal/go-nepali/nepalitime"
+)
+
+// DefaultFormat is the format of nepaliDate and nepaliDateNe without the format argument
+const DefaultFormat = "%B %d, %Y, %A"
+
+// FuncMap returns the template functions.
+// It can be used as text/template.FuncMap and html/template.FuncMap.
+//
+// The date arguments can be time.Time, *time.Time, *nepalitime.NepaliTime or nepalitime.NepaliDate,
+// the functions return empty string for nil.
+// The times are converted into the nepali time in Asia/Kathmandu.
+//
+//	nepaliDate DATE [FORMAT]    formats the date with the date directives, DefaultFormat if FORMAT is missing
+//	nepaliDateNe DATE [FORMAT]  same as nepaliDate but with the devanagari digits, month and weekday names
+//	bsNow                       current nepali time (*nepalitime.NepaliTime)
+//	toBS DATE                   nepali time (*nepalitime.NepaliTime) of the date
+//	toAD DATE                   english time (time.Time) of the date
+//	devanagari VALUE            value with the digits in devanagari, eg. 2079 => २०७९
+//	nepaliComma NUMBER          number grouped in lakh and crore, eg. 123456 => 1,23,456
+//	nepaliCommaNe NUMBER        same as nepaliComma but with the devanagari digits, eg. १,२३,४५६
+//	timeSince DATE              relative time to now, eg. "3 days ago", "in 2 months"
+//	timeSinceNe DATE            relative time to now in nepali, eg. "३ दिन अघि"
+//	fiscalYear DATE             nepali fiscal year of the date, eg. "2080/81"
+//	fiscalYearNe DATE           nepali fiscal year of the date in devanagari, eg. "२०८०/८१"
+func FuncMap() map[string]any {
+	return map[string]any{
+		"nepaliDate":   nepaliDate,
+		"nepaliDateNe": nepaliDateNe,
+		"bsNow":        nepalitime.Now,
+		"toBS":         toBS,
+		"toAD":         toAD,
+		"devanagari":   devanagari,
+
+		"nepaliComma":   nepaliComma,
+		"nepaliCommaNe": nepaliCommaNe,
+
+		"timeSince":    timeSince,
+		"timeSinceNe":  timeSinceNe,
+		"fiscalYear":   fiscalYear,
+		"fiscalYearNe": fiscalYearNe,
+	}
+}
+
+func nepaliDate(value any, format ...string) (string, error) {
+	nepaliTime, err := toNepaliTime(value)
+	if err != nil || nepaliTime == nil {
+		return "", err
+	}
+	return nepaliTime.Format(optionalFormat(format)), nil
+}
+
+func nepaliDateNe(value any, format ...string) (string, error) {
+	nepaliTime, err := toNepaliTime(value)
+	if err != nil || nepaliTime == nil {
+		return "", err
+	}
+	return nepalinumber.ToDevanagari(nepaliTime.Format(devanagariNames(optionalFormat(format), nepaliTime))), nil
+}
+
+func optionalFormat(format []string) string {
+	if len(format) == 0 {
+		return DefaultFormat
+	}
+	return format[0]
+}
+
+// replaces the month and weekday name directives of the format with their devanagari names
+//
+// eg. "%d %B" => "%d माघ"
+func devanagariNames(format string, nepaliTime *nepalitime.NepaliTime) string {
+	var builder strings.Builder
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' || i+1 == len(format) {
+			builder.WriteByte(format[i])
+			continue
+		}
+
+		switch format[i+1] {
+		case 'B':
+			builder.WriteString(constants.DevanagariMonths[nepaliTime.Month()-1])
+		case 'A', 'a':
+			builder.WriteString(constants.DevanagariWeekdays[nepaliTime.Weekday()])
+		default:
+			builder.WriteString(format[i : i+2])
+		}
+		i++
+	}
+	return builder.String()
+}
+
+func toBS(value any) (*nepalitime.NepaliTime, error) {
+	return toNepaliTime(value)
+}
+
+func toAD(value any) (time.Time, error) {
+	nepaliTime, err := toNepaliTime(value)
+	if err != nil || nepaliTime == nil {
+		return time.Time{}, err
+	}
+	return nepaliTime.GetEnglishTime(), nil
+}
+
+func devanagari(value any) string {
+	return nepalinumber.ToDevanagari(fmt.Sprint(value))
+}
+
+// NUMBER can be an integer, a float, *big.Int, *big.Float or a number string
+func nepaliComma(value any) (string, error) {
+	switch value := value.(type) {
+	case *big.Int:
+		return nepalinumber.FormatBigInt(value), nil
+	case *big.Float:
+		return nepalinumber.FormatBigFloat(value, -1), nil
+	}
+
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return nepalinumber.FormatInt(rv.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return nepalinumber.FormatUint(rv.Uint()), nil
+	case reflect.Float32:
+		return nepalinumber.Group(strconv.FormatFloat(rv.Float(), 'f', -1, 32))
+	case reflect.Float64:
+		return nepalinumber.FormatFloat(rv.Float(), -1), nil
+	case reflect.String:
+		return nepalinumber.Group(rv.String())
+	}
+	return "", fmt.Errorf("unsupported number type %T", value)
+}
+
+func nepaliCommaNe(value any) (string, error) {
+	text, err := nepaliComma(value)
+	return nepalinumber.ToDevanagari(text), err
+}
+
+func timeSince(value any) (string, error) {
+	return relativeTime(value, nepalitime.English)
+}
+
+func timeSinceNe(value any) (string, error) {
+	return relativeTime(value, nepalitime.Nepali)
+}
+
+func relativeTime(value any, language nepalitime.Language) (string, error) {
+	nepaliTime, err := toNepaliTime(value)
+	if err != nil || nepaliTime == nil {
+		return "", err
+	}
+	return nepalitime.NewHumanizer(language).RelativeTo(nepaliTime, nepalitime.Now()), nil
+}
+
+func fiscalYear(value any) (string, error) {
+	nepaliTime, err := toNepaliTime(value)
+	if err != nil || nepaliTime == nil {
+		return "", err
+	}
+
+	start, err := nepaliTime.NepaliDate().StartOf(nepalitime.FiscalYear)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%d/%02d", start.Year(), (start.Year()+1)%100), nil
+}
+
+func fiscalYearNe(value any) (string, error) {
+	text, err := fiscalYear(value)
+	return nepalinumber.ToDevanagari(text), err
+}
+
+// returns the nepali time of the date argument, nil for nil
+func toNepaliTime(value any) (*nepalitime.NepaliTime, error) {
+	switch value := value.(type) {
+	case *nepalitime.NepaliTime:
+		return value, nil
+	case nepalitime.NepaliDate:
+		return value.Time(), nil
+	case time.Time:
+		return nepalitime.FromEnglishTime(value)
+	case *time.Time:
+		if value == nil {
+			return nil, nil
+		}
+		return nepalitime.FromEnglishTime(*value)
+	case nil:
+		return nil, nil
+	}
+
+	if rv := reflect.ValueOf(value); rv.Kind() == reflect.Pointer && rv.IsNil() {
+		return nil, nil
+	}
+	return nil, fmt.Errorf("unsupported date type %T", value)
+}
@@ -0,0 +1,171 @@
+package nepalitemplate_test
+
+import (
+	htmltemplate "html/template"
+	"math/big"
+	"strings"
+	"testing"
+	"text/template"
+	"time"
+
+	"github.com/opensource-nepal/go-nepali/nepalitemplate"
+	"github.com/opensource-nepal/go-nepali/nepalitime"
+	"github.com/stretchr/testify/assert"
+)
+
+// 2023-01-28 16:23:17 in Asia/Kathmandu is 2079-10-14 (Saturday)
+var createdAt = time.Date(2023, 1, 28, 16, 23, 17, 0, nepalitime.GetNepaliLocation())
+
+func execute(t *testing.T, text string, data any) string {
+	t.Helper()
+
+	tmpl, err := template.New("test").Funcs(nepalitemplate.FuncMap()).Parse(text)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var builder strings.Builder
+	if err := tmpl.Execute(&builder, data); err != nil {
+		t.Fatal(err)
+	}
+	return builder.String()
+}
+
+func TestNepaliDate(t *testing.T) {
+	nepaliTime, _ := nepalitime.FromEnglishTime(createdAt)
+	date := nepaliTime.NepaliDate()
+
+	testCases := []struct {
+		text string
+		data any
+		want string
+	}{
+		{`{{ nepaliDate . "%d %B %Y" }}`, createdAt, "14 Magh 2079"},
+		{`{{ nepaliDate . "%d %B %Y" }}`, &createdAt, "14 Magh 2079"},
+		{`{{ nepaliDate . "%Y-%m-%d %H:%M" }}`, nepaliTime, "2079-10-14 16:23"},
+		{`{{ nepaliDate . "%d %B %Y" }}`, date, "14 Magh 2079"},
+		{`{{ nepaliDate . }}`, createdAt, "Magh 14, 2079, Saturday"},
+		{`{{ nepaliDate .CreatedAt "%d %B %Y" }}`, struct{ CreatedAt time.Time }{createdAt}, "14 Magh 2079"},
+		{`{{ nepaliDate . "%d %B %Y" }}`, (*time.Time)(nil), ""},
+		{`{{ nepaliDate . "%d %B %Y" }}`, (*nepalitime.NepaliTime)(nil), ""},
+	}
+
+	for _, tc := range testCases {
+		assert.Equal(t, tc.want, execute(t, tc.text, tc.data), tc.text)
+	}
+}
+
+func TestNepaliDateNe(t *testing.T) {
+	testCases := []struct {
+		text string
+		want string
+	}{
+		{`{{ nepaliDateNe . "%d %B %Y" }}`, "१४ माघ २०७९"},
+		{`{{ nepaliDateNe . "%Y/%m/%d, %A" }}`, "२०७९/१०/१४, शनिबार"},
+		{`{{ nepaliDateNe . "%-d %B, 100%%" }}`, "१४ माघ, १००%"},
+		{`{{ nepaliDateNe . }}`, "माघ १४, २०७९, शनिबार"},
+	}
+
+	for _, tc := range testCases {
+		assert.Equal(t, tc.want, execute(t, tc.text, createdAt), tc.text)
+	}
+}
+
+func TestNepaliDateUnsupportedType(t *testing.T) {
+	tmpl := template.Must(template.New("test").Funcs(nepalitemplate.FuncMap()).Parse(`{{ nepaliDate . "%Y" }}`))
+
+	err := tmpl.Execute(&strings.Builder{}, "2079-10-14")
+	assert.ErrorContains(t, err, "unsupported date type string")
+}
+
+func TestBSNow(t *testing.T) {
+	restore := nepalitime.SetClock(nepalitime.NewFakeClock(createdAt))
+	t.Cleanup(restore)
+
+	assert.Equal(t, "2079-10-14 16:23:17", execute(t, `{{ bsNow }}`, nil))
+	assert.Equal(t, "2079", execute(t, `{{ bsNow.Year }}`, nil))
+	assert.Equal(t, "14 Magh", execute(t, `{{ nepaliDate bsNow "%d %B" }}`, nil))
+}
+
+func TestConversion(t *testing.T) {
+	date, _ := nepalitime.NewDate(2079, 10, 14)
+
+	assert.Equal(t, "2079-10-14 16:23:17", execute(t, `{{ toBS . }}`, createdAt))
+	assert.Equal(t, "2023-01-28", execute(t, `{{ (toAD .).Format "2006-01-02" }}`, date))
+}
+
+func TestDevanagari(t *testing.T) {
+	testCases := []struct {
+		data any
+		want string
+	}{
+		{1250, "१२५०"},
+		{12.5, "१२.५"},
+		{"Rs. 1,250", "Rs. १,२५०"},
+		{"२०७९", "२०७९"},
+	}
+
+	for _, tc := range testCases {
+		assert.Equal(t, tc.want, execute(t, `{{ devanagari . }}`, tc.data))
+	}
+}
+
+func TestNepaliComma(t *testing.T) {
+	bigNumber, _ := new(big.Int).SetString("1234567890123", 10)
+
+	testCases := []struct {
+		data any
+		want string
+	}{
+		{123456, "1,23,456"},
+		{uint8(200), "200"},
+		{-1234567.5, "-12,34,567.5"},
+		{float32(1234.5), "1,234.5"},
+		{"123456789.50", "12,34,56,789.50"},
+		{bigNumber, "12,34,56,78,90,123"},
+	}
+
+	for _, tc := range testCases {
+		assert.Equal(t, tc.want, execute(t, `{{ nepaliComma . }}`, tc.data))
+	}
+	assert.Equal(t, "रु. १,२३,४५६", execute(t, `रु. {{ nepaliCommaNe . }}`, 123456))
+
+	tmpl := template.Must(template.New("test").Funcs(nepalitemplate.FuncMap()).Parse(`{{ nepaliComma . }}`))
+	assert.ErrorContains(t, tmpl.Execute(&strings.Builder{}, "abc"), "invalid number")
+	assert.ErrorContains(t, tmpl.Execute(&strings.Builder{}, true), "unsupported number type bool")
+}
+
+func TestTimeSince(t *testing.T) {
+	restore := nepalitime.SetClock(nepalitime.NewFakeClock(createdAt))
+	t.Cleanup(restore)
+
+	assert.Equal(t, "3 days ago", execute(t, `{{ timeSince . }}`, createdAt.AddDate(0, 0, -3)))
+	assert.Equal(t, "in 2 hours", execute(t, `{{ timeSince . }}`, createdAt.Add(2*time.Hour)))
+	assert.Equal(t, "३ दिन अघि", execute(t, `{{ timeSinceNe . }}`, createdAt.AddDate(0, 0, -3)))
+}
+
+func TestFiscalYear(t *testing.T) {
+	testCases := []struct {
+		date [3]int
+		want string
+	}{
+		{[3]int{2079, 10, 14}, "2079/80"},
+		{[3]int{2080, 3, 31}, "2079/80"},
+		{[3]int{2080, 4, 1}, "2080/81"},
+		{[3]int{2099, 12, 1}, "2099/00"},
+	}
+
+	for _, tc := range testCases {
+		date, _ := nepalitime.NewDate(tc.date[0], tc.date[1], tc.date[2])
+		assert.Equal(t, tc.want, execute(t, `{{ fiscalYear . }}`, date), date.String())
+	}
+	assert.Equal(t, "आ.व. २०७९/८०", execute(t, `आ.व. {{ fiscalYearNe . }}`, createdAt))
+}
+
+func TestFuncMapWithHTMLTemplate(t *testing.T) {
+	tmpl := htmltemplate.Must(htmltemplate.New("test").Funcs(nepalitemplate.FuncMap()).Parse(
+		`<time datetime="{{ (toAD .).Format "2006-01-02" }}">{{ nepaliDateNe . "%d %B %Y" }}</time>`))
+
+	var builder strings.Builder
+	assert.NoError(t, tmpl.Execute(&builder, createdAt))
+	assert.Equal(t, `<time datetime="2023-01-28">१४ माघ २०७९</time>`, builder.String())
+}
@@ -0,0 +1,69 @@
+package nepalitime
+
+import (
+	"github.com/opensource-nepal/go-nepali/dateConverter"
+)
+
+// Age returns the age on asOf of a person born on birth, in completed
+// nepali years, months and days.
+//
+// Nepali months have 29 to 32 days and the count differs every year, so a
+// birthday on a day that doesn't exist in a month (eg. Ashadh 32 in a year
+// where Ashadh has 31 days) is considered reached on the last day of that
+// month.
+//
+// Returns zeros if asOf is before birth.
+func Age(birth, asOf NepaliDate) (years, months, days int) {
+	if asOf.Before(birth) {
+		return 0, 0, 0
+	}
+
+	// YEAR
+	years = asOf.year - birth.year
+	if asOf.Before(anniversary(birth, asOf.year, birth.month)) {
+		years--
+	}
+
+	// MONTH
+	// incrementing month until the next monthly anniversary passes asOf
+	year, month := birth.year+years, birth.month
+	for {
+		nextYear, nextMonth := year, month+1
+		if nextMonth > 12 {
+			nextYear, nextMonth = nextYear+1, 1
+		}
+		if nextYear > asOf.year || asOf.Before(anniversary(birth, nextYear, nextMonth)) {
+			break
+		}
+		year, month = nextYear, nextMonth
+		months++
+	}
+
+	// DAY
+	days = asOf.Sub(anniversary(birth, year, month))
+
+	return years, months, days
+}
+
+// ReachesAge returns the date on which a person born on birth turns n years old.
+// The same day 32 rule as Age() applies.
+// Returns error if the date is out of the supported range.
+func ReachesAge(birth NepaliDate, n int) (NepaliDate, error) {
+	year := birth.year + n
+	if year < dateConverter.NepaliMinYear() || year > dateConverter.NepaliMaxYear() {
+		return NepaliDate{}, dateConverter.ErrOutOfRange
+	}
+
+	return anniversary(birth, year, birth.month), nil
+}
+
+// returns the day of birth in the given year and month,
+// falling back to the last day of the month if the day doesn't exist.
+// year and month should be within range.
+func anniversary(birth NepaliDate, year, month int) NepaliDate {
+	day := birth.day
+	if monthDays, _ := dateConverter.NepaliMonthDays(year, month); day > monthDays {
+		day = monthDays
+	}
+	return NepaliDate{year, month, day}
+}
@@ -0,0 +1,66 @@
+package nepalitime_test
+
+import (
+	"testing"
+
+	"github.com/opensource-nepal/go-nepali/nepalitime"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAge(t *testing.T) {
+	years, months, days := nepalitime.Age(mustDate(t, 2050, 1, 15), mustDate(t, 2079, 10, 14))
+	assert.Equal(t, 29, years)
+	assert.Equal(t, 8, months)
+	assert.Equal(t, 29, days)
+}
+
+func TestAgeOnBirthday(t *testing.T) {
+	years, months, days := nepalitime.Age(mustDate(t, 2063, 5, 10), mustDate(t, 2079, 5, 10))
+	assert.Equal(t, 16, years)
+	assert.Equal(t, 0, months)
+	assert.Equal(t, 0, days)
+}
+
+func TestAgeBeforeBirth(t *testing.T) {
+	years, months, days := nepalitime.Age(mustDate(t, 2079, 5, 10), mustDate(t, 2079, 5, 9))
+	assert.Equal(t, [3]int{0, 0, 0}, [3]int{years, months, days})
+}
+
+func TestAgeForBirthOnDay32(t *testing.T) {
+	// Ashadh 2080 has only 31 days
+	birth := mustDate(t, 2079, 3, 32)
+
+	years, months, days := nepalitime.Age(birth, mustDate(t, 2080, 3, 31))
+	assert.Equal(t, [3]int{1, 0, 0}, [3]int{years, months, days})
+
+	years, months, days = nepalitime.Age(birth, mustDate(t, 2080, 3, 30))
+	assert.Equal(t, [3]int{0, 11, 30}, [3]int{years, months, days})
+}
+
+func TestAgeAcross366DayYear(t *testing.T) {
+	// 2081 has 366 days and Chaitra 2081 has 31 days
+	birth := mustDate(t, 2080, 12, 30)
+
+	years, months, days := nepalitime.Age(birth, mustDate(t, 2081, 12, 30))
+	assert.Equal(t, [3]int{1, 0, 0}, [3]int{years, months, days})
+
+	years, months, days = nepalitime.Age(birth, mustDate(t, 2081, 12, 31))
+	assert.Equal(t, [3]int{1, 0, 1}, [3]int{years, months, days})
+}
+
+func TestReachesAge(t *testing.T) {
+	d, err := nepalitime.ReachesAge(mustDate(t, 2063, 5, 10), 16)
+	assert.Nil(t, err)
+	assert.Equal(t, "2079-05-10", d.String())
+}
+
+func TestReachesAgeForBirthOnDay32(t *testing.T) {
+	d, err := nepalitime.ReachesAge(mustDate(t, 2079, 3, 32), 1)
+	assert.Nil(t, err)
+	assert.Equal(t, "2080-03-31", d.String())
+}
+
+func TestReachesAgeOutOfRange(t *testing.T) {
+	_, err := nepalitime.ReachesAge(mustDate(t, 2050, 1, 1), 58)
+	assert.NotNil(t, err)
+}
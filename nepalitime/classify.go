@@ -0,0 +1,242 @@
+package nepalitime
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/opensource-nepal/go-nepali/dateConverter"
+	"github.com/opensource-nepal/go-nepali/nepalinumber"
+)
+
+// Calendar of a date
+type Calendar int
+
+const (
+	// AmbiguousCalendar is used when a date is valid in both calendars.
+	AmbiguousCalendar Calendar = iota
+	BS                         // Bikram Sambat, the nepali calendar
+	AD                         // Gregorian calendar
+)
+
+func (c Calendar) String() string {
+	switch c {
+	case BS:
+		return "BS"
+	case AD:
+		return "AD"
+	default:
+		return "Ambiguous"
+	}
+}
+
+// ErrAmbiguousCalendar is returned when a date string is valid in both BS and AD
+// and no calendar is preferred.
+var ErrAmbiguousCalendar = errors.New("date is valid in both BS and AD")
+
+// Classification is the result of ClassifyDate()
+type Classification struct {
+	Calendar Calendar
+
+	// Confidence of the classification, in the range [0, 1].
+	// 0.5 for an ambiguous date.
+	Confidence float64
+}
+
+// confidence when the date is valid only in one calendar,
+// less than 1 since the string may be a typo of the other calendar
+const validityConfidence = 0.9
+
+// ClassifyDate detects whether the date string is in BS or AD.
+//
+// The year, month and day are extracted from the string (year-month-day,
+// day-month-year or month-day-year, with digits in ascii or devanagari and
+// month as number or name) and checked against the supported ranges of
+// dateConverter and the month lengths of both calendars.
+// eg. "2079/10/14" is BS since AD 2079 is out of range, "1994/08/13" is AD since
+// BS 1994 is out of range, "2030/02/32" is BS since no AD month has 32 days and
+// "2030/01/15" is ambiguous.
+//
+// Month names decide the calendar, eg. "14 Magh 2079" is BS and "28 January 2023" is AD.
+//
+// Returns error if the year, month and day can't be found in the string
+// or the date is not valid in any calendar.
+func ClassifyDate(dateStr string) (Classification, error) {
+	candidates, err := dateCandidates(dateStr)
+	if err != nil {
+		return Classification{}, err
+	}
+
+	var validBS, validAD bool
+	decisive := false
+	for _, candidate := range candidates {
+		validBS = validBS || candidate.calendar == BS
+		validAD = validAD || candidate.calendar == AD
+		decisive = decisive || candidate.byMonthName
+	}
+
+	switch {
+	case validBS && validAD:
+		return Classification{AmbiguousCalendar, 0.5}, nil
+	case decisive && validBS:
+		return Classification{BS, 1}, nil
+	case decisive && validAD:
+		return Classification{AD, 1}, nil
+	case validBS:
+		return Classification{BS, validityConfidence}, nil
+	case validAD:
+		return Classification{AD, validityConfidence}, nil
+	default:
+		return Classification{}, dateConverter.ErrOutOfRange
+	}
+}
+
+// ParseBSOrAD parses the date string of either calendar into NepaliTime.
+// The calendar is detected with ClassifyDate(), prefer is used for ambiguous dates.
+// Pass AmbiguousCalendar to get ErrAmbiguousCalendar for ambiguous dates instead.
+//
+// Returns *AmbiguousError if the day and month order can't be decided, eg. "05/06/2079".
+func ParseBSOrAD(dateStr string, prefer Calendar) (*NepaliTime, Calendar, error) {
+	classification, err := ClassifyDate(dateStr)
+	if err != nil {
+		return nil, AmbiguousCalendar, err
+	}
+
+	calendar := classification.Calendar
+	if calendar == AmbiguousCalendar {
+		if prefer == AmbiguousCalendar {
+			return nil, AmbiguousCalendar, ErrAmbiguousCalendar
+		}
+		calendar = prefer
+	}
+
+	// candidates are validated by dateCandidates
+	candidates, _ := dateCandidates(dateStr)
+	var (
+		date    [3]int
+		layouts []string
+	)
+	for _, candidate := range candidates {
+		if candidate.calendar != calendar {
+			continue
+		}
+		if len(layouts) > 0 && candidate.date != date {
+			layouts = append(layouts, candidate.layout)
+			return nil, calendar, &AmbiguousError{Value: dateStr, Layouts: layouts}
+		}
+		date = candidate.date
+		layouts = append(layouts, candidate.layout)
+	}
+
+	if calendar == BS {
+		nepaliTime, err := Date(date[0], date[1], date[2], 0, 0, 0, 0)
+		return nepaliTime, calendar, err
+	}
+
+	enTime := time.Date(date[0], time.Month(date[1]), date[2], 0, 0, 0, 0, GetNepaliLocation())
+	nepaliTime, err := FromEnglishTime(enTime)
+	return nepaliTime, calendar, err
+}
+
+// a valid interpretation of a date string
+type dateCandidate struct {
+	calendar    Calendar
+	date        [3]int // year, month, day
+	layout      string
+	byMonthName bool
+}
+
+var (
+	numberRe = regexp.MustCompile(`\d+`)
+	wordRe   = regexp.MustCompile(`[\p{L}\p{M}]+`)
+)
+
+// returns the valid interpretations of the date string in both calendars
+func dateCandidates(dateStr string) ([]dateCandidate, error) {
+	dateStr = nepalinumber.ToASCII(dateStr)
+	numbers := numberRe.FindAllString(dateStr, -1)
+
+	// month from the name
+	var (
+		nameMonth    int
+		nameCalendar Calendar
+	)
+	for _, word := range wordRe.FindAllString(dateStr, -1) {
+		if month := monthFromName(word); month != 0 {
+			nameMonth, nameCalendar = month, BS
+		} else if month := englishMonthFromName(word); month != 0 {
+			nameMonth, nameCalendar = month, AD
+		}
+	}
+
+	type interpretation struct {
+		layout           string
+		year, month, day string
+	}
+	var interpretations []interpretation
+
+	switch {
+	case nameMonth != 0 && len(numbers) == 2:
+		monthStr := strconv.Itoa(nameMonth)
+		if len(numbers[0]) == 4 {
+			interpretations = append(interpretations, interpretation{"%Y %B %d", numbers[0], monthStr, numbers[1]})
+		} else if len(numbers[1]) == 4 {
+			interpretations = append(interpretations, interpretation{"%d %B %Y", numbers[1], monthStr, numbers[0]})
+		}
+	case nameMonth == 0 && len(numbers) == 3:
+		if len(numbers[0]) == 4 {
+			interpretations = append(interpretations, interpretation{"%Y-%m-%d", numbers[0], numbers[1], numbers[2]})
+		} else if len(numbers[2]) == 4 {
+			interpretations = append(interpretations,
+				interpretation{"%d-%m-%Y", numbers[2], numbers[1], numbers[0]},
+				interpretation{"%m-%d-%Y", numbers[2], numbers[0], numbers[1]},
+			)
+		}
+	}
+
+	if len(interpretations) == 0 {
+		return nil, errors.New("unable to find year, month and day in the date string")
+	}
+
+	var candidates []dateCandidate
+	for _, i := range interpretations {
+		year, _ := strconv.Atoi(i.year)
+		month, _ := strconv.Atoi(i.month)
+		day, _ := strconv.Atoi(i.day)
+		date := [3]int{year, month, day}
+
+		if nameCalendar != AD && dateConverter.IsValidNepaliDate(year, month, day) {
+			candidates = append(candidates, dateCandidate{BS, date, i.layout, nameCalendar == BS})
+		}
+		if nameCalendar != BS && isValidEnglishDate(year, month, day) {
+			candidates = append(candidates, dateCandidate{AD, date, i.layout, nameCalendar == AD})
+		}
+	}
+
+	return candidates, nil
+}
+
+// checks if the english date exists and is within the range of dateConverter
+func isValidEnglishDate(year, month, day int) bool {
+	if year < dateConverter.EnglishMinYear() || year > dateConverter.EnglishMaxYear() {
+		return false
+	}
+	if month < 1 || month > 12 || day < 1 {
+		return false
+	}
+	// time.Date normalizes the invalid days into the next month
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC).Day() == day
+}
+
+// returns the month number of the english month name (full or first 3 letters),
+// 0 if the name is not a month
+func englishMonthFromName(name string) int {
+	for month := time.January; month <= time.December; month++ {
+		if strings.EqualFold(month.String(), name) || strings.EqualFold(month.String()[:3], name) {
+			return int(month)
+		}
+	}
+	return 0
+}
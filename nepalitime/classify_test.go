@@ -0,0 +1,86 @@
+package nepalitime_test
+
+import (
+	"testing"
+
+	"github.com/opensource-nepal/go-nepali/nepalitime"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClassifyDate(t *testing.T) {
+	testCases := []struct {
+		dateStr    string
+		calendar   nepalitime.Calendar
+		confidence float64
+	}{
+		{"2079/10/14", nepalitime.BS, 0.9}, // AD 2079 is out of range
+		{"1994-08-13", nepalitime.AD, 0.9}, // BS 1994 is out of range
+		{"2030/02/32", nepalitime.BS, 0.9}, // no AD month has 32 days
+		{"2030/02/30", nepalitime.BS, 0.9}, // February has no 30th day
+		{"2030/09/30", nepalitime.AD, 0.9}, // Poush 2030 has 29 days
+		{"2030/01/15", nepalitime.AmbiguousCalendar, 0.5},
+		{"14 Magh 2079", nepalitime.BS, 1},
+		{"१४ माघ २०७९", nepalitime.BS, 1},
+		{"28 January 2023", nepalitime.AD, 1},
+		{"Jan 28, 2023", nepalitime.AD, 1},
+		{"14/10/2079", nepalitime.BS, 0.9},
+	}
+
+	for _, tc := range testCases {
+		classification, err := nepalitime.ClassifyDate(tc.dateStr)
+		if assert.Nil(t, err, tc.dateStr) {
+			assert.Equal(t, tc.calendar, classification.Calendar, tc.dateStr)
+			assert.Equal(t, tc.confidence, classification.Confidence, tc.dateStr)
+		}
+	}
+}
+
+func TestClassifyDateWithInvalidDate(t *testing.T) {
+	for _, dateStr := range []string{"2079/13/01", "3000/01/01", "2079/10", "hello"} {
+		_, err := nepalitime.ClassifyDate(dateStr)
+		assert.NotNil(t, err, dateStr)
+	}
+}
+
+func TestCalendarString(t *testing.T) {
+	assert.Equal(t, "BS", nepalitime.BS.String())
+	assert.Equal(t, "AD", nepalitime.AD.String())
+	assert.Equal(t, "Ambiguous", nepalitime.AmbiguousCalendar.String())
+}
+
+func TestParseBSOrAD(t *testing.T) {
+	for _, dateStr := range []string{"2079/10/14", "28 January 2023", "१४ माघ २०७९"} {
+		got, _, err := nepalitime.ParseBSOrAD(dateStr, nepalitime.AmbiguousCalendar)
+		if assert.Nil(t, err, dateStr) {
+			assert.Equal(t, "2079-10-14 00:00:00", got.String(), dateStr)
+		}
+	}
+}
+
+func TestParseBSOrADReturnsCalendar(t *testing.T) {
+	_, calendar, err := nepalitime.ParseBSOrAD("Jan 28, 2023", nepalitime.AmbiguousCalendar)
+	assert.Nil(t, err, "error should be nil")
+	assert.Equal(t, nepalitime.AD, calendar)
+}
+
+func TestParseBSOrADWithAmbiguousCalendar(t *testing.T) {
+	_, _, err := nepalitime.ParseBSOrAD("2030/01/15", nepalitime.AmbiguousCalendar)
+	assert.ErrorIs(t, err, nepalitime.ErrAmbiguousCalendar)
+
+	got, calendar, err := nepalitime.ParseBSOrAD("2030/01/15", nepalitime.BS)
+	assert.Nil(t, err, "error should be nil")
+	assert.Equal(t, nepalitime.BS, calendar)
+	assert.Equal(t, "2030-01-15 00:00:00", got.String())
+
+	got, calendar, err = nepalitime.ParseBSOrAD("2030/01/15", nepalitime.AD)
+	assert.Nil(t, err, "error should be nil")
+	assert.Equal(t, nepalitime.AD, calendar)
+	assert.Equal(t, "2086-10-01 00:00:00", got.String())
+}
+
+func TestParseBSOrADWithAmbiguousDayAndMonth(t *testing.T) {
+	_, _, err := nepalitime.ParseBSOrAD("05/06/2079", nepalitime.AmbiguousCalendar)
+
+	var ambiguousErr *nepalitime.AmbiguousError
+	assert.ErrorAs(t, err, &ambiguousErr)
+}
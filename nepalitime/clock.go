@@ -0,0 +1,148 @@
+package nepalitime
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Clock provides the current time.
+// Every function of the package that reads the current time, eg. Now(),
+// GetCurrentEnglishTime() and Since(), gets it from the installed Clock.
+//
+// Use SetClock() to install a clock for the package and WithClock()
+// to use a clock for a context.
+type Clock interface {
+	Now() time.Time
+}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+// RealClock reads the system time. It's the default clock of the package.
+var RealClock Clock = realClock{}
+
+// wrapper since atomic.Value requires the same concrete type on every store
+type clockHolder struct {
+	clock Clock
+}
+
+var installedClock atomic.Value
+
+func init() {
+	installedClock.Store(clockHolder{RealClock})
+}
+
+// SetClock installs the clock used by the package
+// and returns a function which restores the previous clock.
+//
+// USAGE (in tests):
+//
+//	clock := nepalitime.NewFakeClock(time.Date(2023, 4, 13, 0, 0, 0, 0, nepalitime.GetNepaliLocation()))
+//	defer nepalitime.SetClock(clock)()
+func SetClock(clock Clock) (restore func()) {
+	if clock == nil {
+		clock = RealClock
+	}
+	previous := installedClock.Swap(clockHolder{clock}).(clockHolder)
+
+	return func() {
+		installedClock.Store(previous)
+	}
+}
+
+// GetClock returns the clock installed for the package.
+func GetClock() Clock {
+	return installedClock.Load().(clockHolder).clock
+}
+
+type clockContextKey struct{}
+
+// WithClock returns a copy of ctx which carries the clock.
+// The clock is used by the *Context functions, eg. NowContext().
+func WithClock(ctx context.Context, clock Clock) context.Context {
+	return context.WithValue(ctx, clockContextKey{}, clock)
+}
+
+// ClockFromContext returns the clock carried by ctx,
+// or the clock installed for the package if ctx doesn't carry any.
+func ClockFromContext(ctx context.Context) Clock {
+	if clock, ok := ctx.Value(clockContextKey{}).(Clock); ok && clock != nil {
+		return clock
+	}
+	return GetClock()
+}
+
+// FakeClock is a settable Clock for tests.
+// It's frozen when created and only moves with Set() and Advance(),
+// Unfreeze() makes it tick along with the system time.
+type FakeClock struct {
+	mu     sync.Mutex
+	now    time.Time
+	frozen bool
+	// system time when the clock was unfrozen
+	unfrozenAt time.Time
+}
+
+// NewFakeClock returns a frozen FakeClock set to t.
+func NewFakeClock(t time.Time) *FakeClock {
+	return &FakeClock{now: t, frozen: true}
+}
+
+// Now returns the current time of the clock.
+func (obj *FakeClock) Now() time.Time {
+	obj.mu.Lock()
+	defer obj.mu.Unlock()
+
+	return obj.current()
+}
+
+// Set sets the current time of the clock to t.
+func (obj *FakeClock) Set(t time.Time) {
+	obj.mu.Lock()
+	defer obj.mu.Unlock()
+
+	obj.now = t
+	obj.unfrozenAt = time.Now()
+}
+
+// Advance moves the clock forward by d (or backward for negative d).
+func (obj *FakeClock) Advance(d time.Duration) {
+	obj.mu.Lock()
+	defer obj.mu.Unlock()
+
+	obj.now = obj.current().Add(d)
+	obj.unfrozenAt = time.Now()
+}
+
+// Freeze stops the clock at its current time.
+func (obj *FakeClock) Freeze() {
+	obj.mu.Lock()
+	defer obj.mu.Unlock()
+
+	obj.now = obj.current()
+	obj.frozen = true
+}
+
+// Unfreeze makes the clock tick along with the system time from its current time.
+func (obj *FakeClock) Unfreeze() {
+	obj.mu.Lock()
+	defer obj.mu.Unlock()
+
+	if obj.frozen {
+		obj.frozen = false
+		obj.unfrozenAt = time.Now()
+	}
+}
+
+// current time, should be called with the lock held
+func (obj *FakeClock) current() time.Time {
+	if obj.frozen {
+		return obj.now
+	}
+	return obj.now.Add(time.Since(obj.unfrozenAt))
+}
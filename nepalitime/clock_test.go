@@ -0,0 +1,69 @@
+package nepalitime_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/opensource-nepal/go-nepali/nepalitime"
+	"github.com/stretchr/testify/assert"
+)
+
+// 2079 Chaitra 30 23:58 (last day of the year) in Nepal
+var fakeClockStart = time.Date(2023, 4, 13, 18, 13, 0, 0, time.UTC)
+
+func TestDefaultClockIsRealClock(t *testing.T) {
+	assert.Equal(t, nepalitime.RealClock, nepalitime.GetClock())
+}
+
+func TestSetClockRestoresPreviousClock(t *testing.T) {
+	clock := nepalitime.NewFakeClock(fakeClockStart)
+	restore := nepalitime.SetClock(clock)
+	assert.Equal(t, clock, nepalitime.GetClock())
+
+	restore()
+	assert.Equal(t, nepalitime.RealClock, nepalitime.GetClock())
+}
+
+func TestFakeClockIsFrozen(t *testing.T) {
+	clock := nepalitime.NewFakeClock(fakeClockStart)
+	time.Sleep(time.Millisecond)
+	assert.Equal(t, fakeClockStart, clock.Now())
+}
+
+func TestFakeClockAdvance(t *testing.T) {
+	clock := nepalitime.NewFakeClock(fakeClockStart)
+	defer nepalitime.SetClock(clock)()
+
+	assert.Equal(t, "2079-12-30", nepalitime.Now().NepaliDate().String())
+
+	clock.Advance(2 * time.Minute)
+	assert.Equal(t, "2080-01-01", nepalitime.Now().NepaliDate().String())
+}
+
+func TestFakeClockSet(t *testing.T) {
+	clock := nepalitime.NewFakeClock(fakeClockStart)
+	clock.Set(fakeClockStart.Add(time.Hour))
+	assert.Equal(t, fakeClockStart.Add(time.Hour), clock.Now())
+}
+
+func TestFakeClockUnfreezeAndFreeze(t *testing.T) {
+	clock := nepalitime.NewFakeClock(fakeClockStart)
+	clock.Unfreeze()
+	time.Sleep(2 * time.Millisecond)
+	clock.Freeze()
+
+	frozenAt := clock.Now()
+	assert.True(t, frozenAt.After(fakeClockStart))
+
+	time.Sleep(time.Millisecond)
+	assert.Equal(t, frozenAt, clock.Now())
+}
+
+func TestClockFromContext(t *testing.T) {
+	assert.Equal(t, nepalitime.RealClock, nepalitime.ClockFromContext(context.Background()))
+
+	clock := nepalitime.NewFakeClock(fakeClockStart)
+	ctx := nepalitime.WithClock(context.Background(), clock)
+	assert.Equal(t, clock, nepalitime.ClockFromContext(ctx))
+}
@@ -0,0 +1,170 @@
+package nepalitime
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+)
+
+// Range is an inclusive range of nepali dates, eg. every day of Shrawan 2080.
+// It is a small comparable value like NepaliDate.
+//
+// The zero value is not a valid range, use NewRange() or RangeOf().
+//
+// USAGE:
+//
+//	shrawan, _ := nepalitime.RangeOf(date, nepalitime.Month)
+//	for day := range shrawan.Days() {
+//		...
+//	}
+type Range struct {
+	start NepaliDate
+	end   NepaliDate
+}
+
+// NewRange returns the range from start to end, both inclusive.
+// Returns error if end is before start.
+func NewRange(start, end NepaliDate) (Range, error) {
+	if end.Before(start) {
+		return Range{}, errors.New("end of the range is before the start")
+	}
+	return Range{start, end}, nil
+}
+
+// RangeOf returns the period of the unit containing the date,
+// eg. RangeOf(date, FiscalYear) of 2080-05-10 is 2080-04-01 to 2081-03-31.
+// Returns error if the period is out of the supported range.
+func RangeOf(date NepaliDate, unit Unit) (Range, error) {
+	start, err := date.StartOf(unit)
+	if err != nil {
+		return Range{}, err
+	}
+	end, err := date.EndOf(unit)
+	if err != nil {
+		return Range{}, err
+	}
+	return Range{start, end}, nil
+}
+
+// String returns the range in the form "2080-04-01..2080-04-32"
+func (r Range) String() string {
+	return r.start.String() + ".." + r.end.String()
+}
+
+// Start returns the first date of the range.
+func (r Range) Start() NepaliDate {
+	return r.start
+}
+
+// End returns the last date of the range.
+func (r Range) End() NepaliDate {
+	return r.end
+}
+
+// Len returns the number of days in the range.
+func (r Range) Len() int {
+	return r.end.Sub(r.start) + 1
+}
+
+// Contains reports whether the date is in the range.
+func (r Range) Contains(date NepaliDate) bool {
+	return !date.Before(r.start) && !date.After(r.end)
+}
+
+// ContainsTime reports whether the nepali date of the time is in the range.
+func (r Range) ContainsTime(t *NepaliTime) bool {
+	return r.Contains(t.NepaliDate())
+}
+
+// Overlaps reports whether the ranges have at least one common date.
+func (r Range) Overlaps(other Range) bool {
+	return !r.start.After(other.end) && !other.start.After(r.end)
+}
+
+// Intersect returns the common dates of the ranges,
+// false if the ranges don't overlap.
+func (r Range) Intersect(other Range) (Range, bool) {
+	if !r.Overlaps(other) {
+		return Range{}, false
+	}
+
+	start, end := r.start, r.end
+	if other.start.After(start) {
+		start = other.start
+	}
+	if other.end.Before(end) {
+		end = other.end
+	}
+	return Range{start, end}, true
+}
+
+// Split splits the range at the boundaries of the unit,
+// eg. Split(Month) of 2080-04-15..2080-06-10 is
+// [2080-04-15..2080-04-32 2080-05-01..2080-05-31 2080-06-01..2080-06-10].
+func (r Range) Split(unit Unit) ([]Range, error) {
+	if unit < Day || unit > FiscalYear {
+		return nil, fmt.Errorf("invalid unit %s", unit)
+	}
+
+	var ranges []Range
+	for period := range r.periods(unit) {
+		ranges = append(ranges, period)
+	}
+	return ranges, nil
+}
+
+// Days returns an iterator over the dates of the range.
+func (r Range) Days() iter.Seq[NepaliDate] {
+	return func(yield func(NepaliDate) bool) {
+		for date := r.start; ; date = date.nextDay() {
+			if !yield(date) || date == r.end {
+				return
+			}
+		}
+	}
+}
+
+// Weeks returns an iterator over the weeks (Sunday to Saturday) of the range.
+// The first and the last weeks are cut to the range.
+func (r Range) Weeks() iter.Seq[Range] {
+	return r.periods(Week)
+}
+
+// Months returns an iterator over the nepali months of the range.
+// The first and the last months are cut to the range.
+func (r Range) Months() iter.Seq[Range] {
+	return r.periods(Month)
+}
+
+// iterator over the periods of the unit, cut to the range
+func (r Range) periods(unit Unit) iter.Seq[Range] {
+	return func(yield func(Range) bool) {
+		for start := r.start; ; {
+			// the end is beyond the range if it is out of the supported range
+			end, err := start.EndOf(unit)
+			if err != nil || end.After(r.end) {
+				end = r.end
+			}
+
+			if !yield(Range{start, end}) || end == r.end {
+				return
+			}
+			start = end.nextDay()
+		}
+	}
+}
+
+// returns the next date using the month lengths,
+// the zero NepaliDate if the next date is out of the supported range
+func (d NepaliDate) nextDay() NepaliDate {
+	if d.day < d.DaysInMonth() {
+		return NepaliDate{d.year, d.month, d.day + 1}
+	}
+
+	year, month := addMonths(d.year, d.month, 1)
+	next, err := NewDate(year, month, 1)
+	if err != nil {
+		return NepaliDate{}
+	}
+	return next
+}
@@ -0,0 +1,169 @@
+package nepalitime_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/opensource-nepal/go-nepali/nepalitime"
+	"github.com/stretchr/testify/assert"
+)
+
+func mustRange(t *testing.T, start, end nepalitime.NepaliDate) nepalitime.Range {
+	t.Helper()
+
+	r, err := nepalitime.NewRange(start, end)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return r
+}
+
+func TestNewRange(t *testing.T) {
+	r, err := nepalitime.NewRange(mustDate(t, 2080, 4, 1), mustDate(t, 2080, 4, 32))
+	assert.Nil(t, err, "error should be nil")
+	assert.Equal(t, "2080-04-01..2080-04-32", r.String())
+	assert.Equal(t, 32, r.Len())
+	assert.Equal(t, mustDate(t, 2080, 4, 1), r.Start())
+	assert.Equal(t, mustDate(t, 2080, 4, 32), r.End())
+
+	_, err = nepalitime.NewRange(mustDate(t, 2080, 4, 2), mustDate(t, 2080, 4, 1))
+	assert.NotNil(t, err, "error should not be nil")
+}
+
+func TestRangeOf(t *testing.T) {
+	r, err := nepalitime.RangeOf(mustDate(t, 2080, 5, 10), nepalitime.FiscalYear)
+	assert.Nil(t, err, "error should be nil")
+	assert.Equal(t, "2080-04-01..2081-03-31", r.String())
+	assert.Equal(t, 365, r.Len())
+
+	r, err = nepalitime.RangeOf(mustDate(t, 2080, 5, 10), nepalitime.Month)
+	assert.Nil(t, err, "error should be nil")
+	assert.Equal(t, "2080-05-01..2080-05-31", r.String())
+
+	_, err = nepalitime.RangeOf(mustDate(t, 2000, 1, 10), nepalitime.FiscalYear)
+	assert.NotNil(t, err, "error should not be nil")
+}
+
+func TestRangeContains(t *testing.T) {
+	r := mustRange(t, mustDate(t, 2080, 4, 1), mustDate(t, 2080, 4, 32))
+
+	assert.True(t, r.Contains(mustDate(t, 2080, 4, 1)))
+	assert.True(t, r.Contains(mustDate(t, 2080, 4, 32)))
+	assert.False(t, r.Contains(mustDate(t, 2080, 3, 31)))
+	assert.False(t, r.Contains(mustDate(t, 2080, 5, 1)))
+
+	assert.True(t, r.ContainsTime(mustTime(t, 2080, 4, 32, 23)))
+	assert.False(t, r.ContainsTime(mustTime(t, 2080, 5, 1, 0)))
+}
+
+func TestRangeOverlapsAndIntersect(t *testing.T) {
+	shrawan := mustRange(t, mustDate(t, 2080, 4, 1), mustDate(t, 2080, 4, 32))
+	bhadra := mustRange(t, mustDate(t, 2080, 5, 1), mustDate(t, 2080, 5, 31))
+	middle := mustRange(t, mustDate(t, 2080, 4, 20), mustDate(t, 2080, 5, 10))
+	lastDay := mustRange(t, mustDate(t, 2080, 4, 32), mustDate(t, 2080, 4, 32))
+
+	assert.False(t, shrawan.Overlaps(bhadra))
+	assert.True(t, shrawan.Overlaps(middle))
+	assert.True(t, middle.Overlaps(bhadra))
+	assert.True(t, shrawan.Overlaps(lastDay))
+
+	got, ok := shrawan.Intersect(middle)
+	assert.True(t, ok)
+	assert.Equal(t, "2080-04-20..2080-04-32", got.String())
+
+	got, ok = middle.Intersect(bhadra)
+	assert.True(t, ok)
+	assert.Equal(t, "2080-05-01..2080-05-10", got.String())
+
+	got, ok = shrawan.Intersect(lastDay)
+	assert.True(t, ok)
+	assert.Equal(t, lastDay, got)
+
+	_, ok = shrawan.Intersect(bhadra)
+	assert.False(t, ok)
+}
+
+func TestRangeDays(t *testing.T) {
+	r := mustRange(t, mustDate(t, 2080, 4, 30), mustDate(t, 2080, 5, 2))
+
+	var days []string
+	for day := range r.Days() {
+		days = append(days, day.String())
+	}
+	assert.Equal(t, []string{"2080-04-30", "2080-04-31", "2080-04-32", "2080-05-01", "2080-05-02"}, days)
+
+	// every day of the fiscal year
+	fy, _ := nepalitime.RangeOf(mustDate(t, 2080, 5, 10), nepalitime.FiscalYear)
+	assert.Len(t, slices.Collect(fy.Days()), fy.Len())
+
+	// stopping the iteration
+	for day := range r.Days() {
+		assert.Equal(t, "2080-04-30", day.String())
+		break
+	}
+}
+
+func TestRangeDaysAtEndOfSupportedRange(t *testing.T) {
+	r := mustRange(t, mustDate(t, 2099, 12, 29), mustDate(t, 2099, 12, 30))
+	assert.Len(t, slices.Collect(r.Days()), 2)
+
+	months := slices.Collect(r.Months())
+	assert.Equal(t, []nepalitime.Range{r}, months)
+}
+
+func TestRangeMonths(t *testing.T) {
+	fy, _ := nepalitime.RangeOf(mustDate(t, 2080, 5, 10), nepalitime.FiscalYear)
+
+	months := slices.Collect(fy.Months())
+	if assert.Len(t, months, 12) {
+		assert.Equal(t, "2080-04-01..2080-04-32", months[0].String())
+		assert.Equal(t, "2080-09-01..2080-09-29", months[5].String())
+		assert.Equal(t, "2081-03-01..2081-03-31", months[11].String())
+	}
+
+	r := mustRange(t, mustDate(t, 2080, 4, 15), mustDate(t, 2080, 6, 10))
+	var got []string
+	for month := range r.Months() {
+		got = append(got, month.String())
+	}
+	assert.Equal(t, []string{"2080-04-15..2080-04-32", "2080-05-01..2080-05-31", "2080-06-01..2080-06-10"}, got)
+}
+
+func TestRangeWeeks(t *testing.T) {
+	// 2080-04-15 is Monday
+	r := mustRange(t, mustDate(t, 2080, 4, 15), mustDate(t, 2080, 4, 32))
+
+	var got []string
+	for week := range r.Weeks() {
+		got = append(got, week.String())
+	}
+	assert.Equal(t, []string{"2080-04-15..2080-04-20", "2080-04-21..2080-04-27", "2080-04-28..2080-04-32"}, got)
+}
+
+func TestRangeSplit(t *testing.T) {
+	fy, _ := nepalitime.RangeOf(mustDate(t, 2080, 5, 10), nepalitime.FiscalYear)
+
+	trimesters, err := fy.Split(nepalitime.Trimester)
+	assert.Nil(t, err, "error should be nil")
+	assert.Equal(t, []string{"2080-04-01..2080-07-30", "2080-08-01..2080-11-30", "2080-12-01..2081-03-31"}, rangeStrings(trimesters))
+
+	r := mustRange(t, mustDate(t, 2079, 11, 1), mustDate(t, 2081, 2, 1))
+	years, err := r.Split(nepalitime.Year)
+	assert.Nil(t, err, "error should be nil")
+	assert.Equal(t, []string{"2079-11-01..2079-12-30", "2080-01-01..2080-12-30", "2081-01-01..2081-02-01"}, rangeStrings(years))
+
+	days, err := r.Split(nepalitime.Day)
+	assert.Nil(t, err, "error should be nil")
+	assert.Len(t, days, r.Len())
+
+	_, err = r.Split(nepalitime.Unit(99))
+	assert.NotNil(t, err, "error should not be nil")
+}
+
+func rangeStrings(ranges []nepalitime.Range) []string {
+	var result []string
+	for _, r := range ranges {
+		result = append(result, r.String())
+	}
+	return result
+}
@@ -0,0 +1,128 @@
+package nepalitime
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// Directive is a custom directive which can be used in both Format() and Parse(),
+// eg. %Q for the fiscal quarter. See RegisterDirective().
+type Directive struct {
+	// Format appends the value of the directive for the nepali time to b
+	// and returns the extended buffer.
+	Format func(b []byte, nepaliTime *NepaliTime) []byte
+
+	// Pattern is the regex matching the value of the directive while parsing,
+	// eg. `[1-4]`. The same pattern is used in all the parse modes.
+	Pattern string
+
+	// Parse sets the fields from the matched value of the directive.
+	// It is called after the builtin directives are parsed, in the order of the format,
+	// so the fields already have the parsed values (month and day are 1 if not parsed).
+	Parse func(value string, fields *DirectiveFields) error
+}
+
+// DirectiveFields are the fields of the nepali time being parsed,
+// set by Directive.Parse.
+type DirectiveFields struct {
+	Year       int // 0 if the year is not parsed yet
+	Month      int
+	Day        int
+	Hour       int
+	Minute     int
+	Second     int
+	Nanosecond int
+}
+
+// directives supported by the formatter or the parser
+const builtinDirectives = "dfHIGjmMSwyYzZBAap"
+
+// prefix of the regex group names of the custom directives,
+// so they don't conflict with the builtin groups
+const customGroupPrefix = "custom_"
+
+var (
+	customDirectivesMu sync.RWMutex
+	customDirectives   = map[string]Directive{}
+)
+
+// RegisterDirective adds the custom directive with the name, eg. "Q" for %Q.
+// The name should be a single ASCII letter which isn't a builtin directive.
+// The '-' flag (eg. %-Q) isn't supported for the custom directives.
+//
+// Directives are meant to be registered while initializing the program
+// and can't be removed or replaced once registered.
+//
+// USAGE:
+//
+//	err := nepalitime.RegisterDirective("Q", nepalitime.Directive{
+//		Format:  func(b []byte, nt *nepalitime.NepaliTime) []byte { ... },
+//		Pattern: `[1-4]`,
+//		Parse:   func(value string, fields *nepalitime.DirectiveFields) error { ... },
+//	})
+func RegisterDirective(name string, directive Directive) error {
+	if len(name) != 1 || !isASCIILetter(name[0]) {
+		return fmt.Errorf("invalid directive name %q", name)
+	}
+	if strings.Contains(builtinDirectives, name) {
+		return fmt.Errorf("directive %%%s is builtin", name)
+	}
+	if directive.Format == nil || directive.Parse == nil || directive.Pattern == "" {
+		return fmt.Errorf("directive %%%s should have Format, Pattern and Parse", name)
+	}
+	if _, err := regexp.Compile(directive.Pattern); err != nil {
+		return fmt.Errorf("invalid pattern of directive %%%s: %w", name, err)
+	}
+
+	customDirectivesMu.Lock()
+	defer customDirectivesMu.Unlock()
+
+	if _, ok := customDirectives[name]; ok {
+		return fmt.Errorf("directive %%%s is already registered", name)
+	}
+	customDirectives[name] = directive
+
+	return nil
+}
+
+// returns the registered custom directive
+func getCustomDirective(name string) (Directive, bool) {
+	customDirectivesMu.RLock()
+	defer customDirectivesMu.RUnlock()
+
+	directive, ok := customDirectives[name]
+	return directive, ok
+}
+
+// regex of the custom directive for the parser
+func customDirectivePattern(name string) (string, bool) {
+	directive, ok := getCustomDirective(name)
+	if !ok {
+		return "", false
+	}
+
+	return `(?P<` + customGroupPrefix + name + `>` + directive.Pattern + `)`, true
+}
+
+// sets the fields from the custom directives of the layout, in the order of the format
+func parseCustomDirectives(data map[string]string, layout *Layout, fields *DirectiveFields) error {
+	for _, group := range layout.re.SubexpNames() {
+		name, ok := strings.CutPrefix(group, customGroupPrefix)
+		if !ok {
+			continue
+		}
+
+		directive, _ := getCustomDirective(name)
+		if err := directive.Parse(data[group], fields); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func isASCIILetter(char byte) bool {
+	return ('a' <= char && char <= 'z') || ('A' <= char && char <= 'Z')
+}
@@ -0,0 +1,144 @@
+package nepalitime_test
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/opensource-nepal/go-nepali/nepalitime"
+	"github.com/stretchr/testify/assert"
+)
+
+// fiscal year starts from Shrawan
+func fiscalYear(month, year int) int {
+	if month >= 4 {
+		return year
+	}
+	return year - 1
+}
+
+func fiscalQuarter(month int) int {
+	return (month+8)%12/3 + 1
+}
+
+var registerFiscalDirectives = sync.OnceFunc(func() {
+	// %K: fiscal year, eg. 2079/80
+	err := nepalitime.RegisterDirective("K", nepalitime.Directive{
+		Format: func(b []byte, nt *nepalitime.NepaliTime) []byte {
+			year := fiscalYear(nt.Month(), nt.Year())
+			return fmt.Appendf(b, "%d/%02d", year, (year+1)%100)
+		},
+		Pattern: `\d{4}/\d\d`,
+		Parse: func(value string, fields *nepalitime.DirectiveFields) error {
+			year, _ := strconv.Atoi(value[:4])
+			if value[5:] != fmt.Sprintf("%02d", (year+1)%100) {
+				return errors.New("invalid value in %K")
+			}
+			if fields.Month < 4 {
+				year++
+			}
+			fields.Year = year
+			return nil
+		},
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	// %Q: fiscal quarter, 1 to 4
+	err = nepalitime.RegisterDirective("Q", nepalitime.Directive{
+		Format: func(b []byte, nt *nepalitime.NepaliTime) []byte {
+			return strconv.AppendInt(b, int64(fiscalQuarter(nt.Month())), 10)
+		},
+		Pattern: `[1-4]`,
+		Parse: func(value string, fields *nepalitime.DirectiveFields) error {
+			if value != strconv.Itoa(fiscalQuarter(fields.Month)) {
+				return &nepalitime.FieldConflictError{Directive: "%Q", Value: value, Expected: strconv.Itoa(fiscalQuarter(fields.Month))}
+			}
+			return nil
+		},
+	})
+	if err != nil {
+		panic(err)
+	}
+})
+
+func TestFormatWithCustomDirective(t *testing.T) {
+	registerFiscalDirectives()
+
+	nt, _ := nepalitime.Date(2079, 10, 14, 0, 0, 0, 0)
+	assert.Equal(t, "2079/80 Q3", nt.Format("%K Q%Q"))
+	assert.Equal(t, "2079/80 Q3", nepalitime.CompileFormat("%K Q%Q").Format(nt))
+
+	nt, _ = nepalitime.Date(2080, 2, 1, 0, 0, 0, 0)
+	assert.Equal(t, "2079/80 Q4", nt.Format("%K Q%Q"))
+
+	nt, _ = nepalitime.Date(2080, 4, 1, 0, 0, 0, 0)
+	assert.Equal(t, "2080/81 Q1", nt.Format("%K Q%Q"))
+}
+
+func TestParseWithCustomDirective(t *testing.T) {
+	registerFiscalDirectives()
+
+	for _, datetimeStr := range []string{"2079/80 Q3 Magh 14", "2079/80 Q3 माघ १४"} {
+		got, err := nepalitime.Parse(datetimeStr, "%K Q%Q %B %d")
+		if assert.Nil(t, err, datetimeStr) {
+			assert.Equal(t, "2079-10-14 00:00:00", got.String(), datetimeStr)
+		}
+	}
+
+	got, err := nepalitime.Parse("2079/80 02/01", "%K %m/%d")
+	assert.Nil(t, err, "error should be nil")
+	assert.Equal(t, "2080-02-01 00:00:00", got.String())
+}
+
+func TestParseWithCustomDirectiveIsSymmetric(t *testing.T) {
+	registerFiscalDirectives()
+
+	nt, _ := nepalitime.Date(2080, 3, 31, 0, 0, 0, 0)
+	format := "%K Q%Q %m-%d"
+
+	got, err := nepalitime.ParseWithOptions(nt.Format(format), format, nepalitime.ParseOptions{Mode: nepalitime.StrictMode})
+	assert.Nil(t, err, "error should be nil")
+	assert.Equal(t, nt.String(), got.String())
+}
+
+func TestParseWithInvalidCustomDirective(t *testing.T) {
+	registerFiscalDirectives()
+
+	_, err := nepalitime.Parse("2079/81 10/14", "%K %m/%d")
+	assert.EqualError(t, err, "invalid value in %K")
+
+	_, err = nepalitime.Parse("2079/80 Q1 10/14", "%K Q%Q %m/%d")
+	var conflictErr *nepalitime.FieldConflictError
+	assert.ErrorAs(t, err, &conflictErr)
+
+	_, err = nepalitime.Parse("2079/80 Q5 10/14", "%K Q%Q %m/%d")
+	assert.NotNil(t, err, "error should not be nil")
+}
+
+func TestRegisterDirectiveWithInvalidDirective(t *testing.T) {
+	registerFiscalDirectives()
+
+	directive := nepalitime.Directive{
+		Format:  func(b []byte, nt *nepalitime.NepaliTime) []byte { return b },
+		Pattern: `\d`,
+		Parse:   func(value string, fields *nepalitime.DirectiveFields) error { return nil },
+	}
+
+	assert.EqualError(t, nepalitime.RegisterDirective("Y", directive), "directive %Y is builtin")
+	assert.EqualError(t, nepalitime.RegisterDirective("K", directive), "directive %K is already registered")
+	assert.EqualError(t, nepalitime.RegisterDirective("QQ", directive), `invalid directive name "QQ"`)
+	assert.EqualError(t, nepalitime.RegisterDirective("1", directive), `invalid directive name "1"`)
+	assert.EqualError(t, nepalitime.RegisterDirective("V", nepalitime.Directive{Pattern: `\d`}), "directive %V should have Format, Pattern and Parse")
+
+	directive.Pattern = `(\d`
+	assert.NotNil(t, nepalitime.RegisterDirective("V", directive), "error should not be nil")
+}
+
+func TestParseWithUnregisteredDirective(t *testing.T) {
+	_, err := nepalitime.Parse("2079 1", "%Y %V")
+	assert.EqualError(t, err, "the format '%V' isn't supported")
+}
@@ -0,0 +1,9 @@
+package nepalitime
+
+// exported for the tests of nepalitime_test package
+var LoadEmbeddedNepaliLocation = loadEmbeddedNepaliLocation
+
+// returns the number of layouts cached by Parse()
+func LayoutCacheLen() int {
+	return parseLayoutCache.len()
+}
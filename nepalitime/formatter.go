@@ -2,7 +2,6 @@ package nepalitime
 
 import (
 	"strconv"
-	"strings"
 
 	"github.com/opensource-nepal/go-nepali/constants"
 )
@@ -16,169 +15,202 @@ type NepaliFormatter struct {
 }
 
 func (obj *NepaliFormatter) Format(format string) string {
-	index, num, timeStr := 0, len(format), ""
-
-	for index < num {
-		char := string(format[index])
-		index++
-
-		if char == "%" && index < num {
-			char = string(format[index])
-
-			if char == "%" {
-				timeStr += char
-			} else if char == "-" {
-				specialChar := char
-
-				if (index + 1) < num {
-					index++
-					char = string(format[index])
-					res := obj.getFormatString(specialChar + char)
-					timeStr += res
-				}
-			} else {
-				res := obj.getFormatString(char)
-				timeStr += res
-			}
-			index++
-		} else {
-			timeStr += char
+	return string(obj.AppendFormat(make([]byte, 0, len(format)+16), format))
+}
+
+// AppendFormat is like Format but appends the textual representation to b
+// and returns the extended buffer.
+func (obj *NepaliFormatter) AppendFormat(b []byte, format string) []byte {
+	for index := 0; index < len(format); {
+		var token formatToken
+		token, index = nextFormatToken(format, index)
+		b = obj.appendToken(b, token)
+	}
+
+	return b
+}
+
+// formatToken is either a literal text or a directive (without the '%')
+type formatToken struct {
+	literal   string
+	directive string
+}
+
+// returns the token starting at the index of the format and the index of the next token
+func nextFormatToken(format string, index int) (formatToken, int) {
+	num := len(format)
+	char := format[index]
+	index++
+
+	if char != '%' || index >= num {
+		return formatToken{literal: format[index-1 : index]}, index
+	}
+
+	switch format[index] {
+	case '%':
+		return formatToken{literal: "%"}, index + 1
+	case '-':
+		if index+1 < num {
+			return formatToken{directive: format[index : index+2]}, index + 2
 		}
+		// trailing "%-" is dropped
+		return formatToken{}, index + 1
+	default:
+		return formatToken{directive: format[index : index+1]}, index + 1
 	}
+}
 
-	return timeStr
+func (obj *NepaliFormatter) appendToken(b []byte, token formatToken) []byte {
+	if token.directive == "" {
+		return append(b, token.literal...)
+	}
+	return obj.appendDirective(b, token.directive)
 }
 
 // utility method that operates based on the type of directive
-func (obj *NepaliFormatter) getFormatString(directive string) string {
+func (obj *NepaliFormatter) appendDirective(b []byte, directive string) []byte {
 	switch directive {
 	case "d":
-		return obj.day_()
+		return obj.day_(b)
 	case "-d":
-		return obj.dayNonzero()
+		return obj.dayNonzero(b)
 	case "m":
-		return obj.monthNumber()
+		return obj.monthNumber(b)
 	case "-m":
-		return obj.monthNumberNonzero()
+		return obj.monthNumberNonzero(b)
 	case "B":
-		return obj.monthName()
+		return obj.monthName(b)
 	case "A":
-		return obj.weekDayFull()
+		return obj.weekDayFull(b)
 	case "a":
-		return obj.weekDayHalf()
+		return obj.weekDayHalf(b)
+	case "w":
+		return obj.weekDayNumber(b)
+	case "j":
+		return obj.yearDay(b)
+	case "-j":
+		return obj.yearDayNonzero(b)
 	case "y":
-		return obj.yearHalf()
+		return obj.yearHalf(b)
 	case "Y":
-		return obj.yearFull()
+		return obj.yearFull(b)
 	case "H":
-		return obj.hour24()
+		return obj.hour24(b)
 	case "-H":
-		return obj.hour24Nonzero()
+		return obj.hour24Nonzero(b)
 	case "I":
-		return obj.hour12()
+		return obj.hour12(b)
 	case "-I":
-		return obj.hour12Nonzero()
+		return obj.hour12Nonzero(b)
 	case "p":
-		return obj.ampm()
+		return obj.ampm(b)
 	case "M":
-		return obj.minute()
+		return obj.minute(b)
 	case "-M":
-		return obj.minuteNonzero()
+		return obj.minuteNonzero(b)
 	case "S":
-		return obj.second()
+		return obj.second(b)
 	case "-S":
-		return obj.secondNonzero()
+		return obj.secondNonzero(b)
 	case "f":
-		return obj.nanosecond_()
+		return obj.nanosecond_(b)
 	case "-f":
-		return obj.nanosecondNonZero()
+		return obj.nanosecondNonZero(b)
+	case "z":
+		return obj.utcOffset(b)
+	case "Z":
+		return obj.timezoneName(b)
 	default:
+		if custom, ok := getCustomDirective(directive); ok {
+			return custom.Format(b, obj.nepaliTime)
+		}
 		// if not match return the directive
-		return "%" + directive
+		return append(append(b, '%'), directive...)
 	}
 }
 
-// %d
-func (obj *NepaliFormatter) day_() string {
-	day := strconv.Itoa(obj.nepaliTime.day)
-
-	if len(day) < 2 {
-		day = "0" + day
+// appends the number zero-padded to the width
+func appendPaddedInt(b []byte, number int, width int) []byte {
+	for digits, n := 1, number; digits < width; digits++ {
+		if n /= 10; n == 0 && number >= 0 {
+			b = append(b, '0')
+		}
 	}
+	return strconv.AppendInt(b, int64(number), 10)
+}
 
-	return day
+// %d
+func (obj *NepaliFormatter) day_(b []byte) []byte {
+	return appendPaddedInt(b, obj.nepaliTime.day, 2)
 }
 
 // -d
-func (obj *NepaliFormatter) dayNonzero() string {
-	day := strconv.Itoa(obj.nepaliTime.day)
-
-	return day
+func (obj *NepaliFormatter) dayNonzero(b []byte) []byte {
+	return strconv.AppendInt(b, int64(obj.nepaliTime.day), 10)
 }
 
 // %m
-func (obj *NepaliFormatter) monthNumber() string {
-	month := strconv.Itoa(obj.nepaliTime.month)
-
-	if len(month) < 2 {
-		month = "0" + month
-	}
-
-	return month
+func (obj *NepaliFormatter) monthNumber(b []byte) []byte {
+	return appendPaddedInt(b, obj.nepaliTime.month, 2)
 }
 
 // %B
-func (obj *NepaliFormatter) monthName() string {
-	return constants.NepaliMonths[obj.nepaliTime.month-1]
+func (obj *NepaliFormatter) monthName(b []byte) []byte {
+	return append(b, constants.NepaliMonths[obj.nepaliTime.month-1]...)
 }
 
 // %A
-func (obj *NepaliFormatter) weekDayFull() string {
-	return obj.nepaliTime.Weekday().String()
+func (obj *NepaliFormatter) weekDayFull(b []byte) []byte {
+	return append(b, obj.nepaliTime.Weekday().String()...)
 }
 
 // %a
-func (obj *NepaliFormatter) weekDayHalf() string {
-	return obj.nepaliTime.Weekday().String()[:3]
+func (obj *NepaliFormatter) weekDayHalf(b []byte) []byte {
+	return append(b, obj.nepaliTime.Weekday().String()[:3]...)
 }
 
-// %-m
-func (obj *NepaliFormatter) monthNumberNonzero() string {
-	month := strconv.Itoa(obj.nepaliTime.month)
+// %w
+func (obj *NepaliFormatter) weekDayNumber(b []byte) []byte {
+	return strconv.AppendInt(b, int64(obj.nepaliTime.Weekday()), 10)
+}
 
-	return month
+// %j
+func (obj *NepaliFormatter) yearDay(b []byte) []byte {
+	return appendPaddedInt(b, obj.nepaliTime.YearDay(), 3)
 }
 
-// %y
-func (obj *NepaliFormatter) yearHalf() string {
-	year := strconv.Itoa(obj.nepaliTime.year)
+// %-j
+func (obj *NepaliFormatter) yearDayNonzero(b []byte) []byte {
+	return strconv.AppendInt(b, int64(obj.nepaliTime.YearDay()), 10)
+}
 
-	return year[2:]
+// %-m
+func (obj *NepaliFormatter) monthNumberNonzero(b []byte) []byte {
+	return strconv.AppendInt(b, int64(obj.nepaliTime.month), 10)
+}
+
+// %y
+func (obj *NepaliFormatter) yearHalf(b []byte) []byte {
+	return appendPaddedInt(b, obj.nepaliTime.year%100, 2)
 }
 
 // %Y
-func (obj *NepaliFormatter) yearFull() string {
-	return strconv.Itoa(obj.nepaliTime.year)
+func (obj *NepaliFormatter) yearFull(b []byte) []byte {
+	return strconv.AppendInt(b, int64(obj.nepaliTime.year), 10)
 }
 
 // %H
-func (obj *NepaliFormatter) hour24() string {
-	hour := strconv.Itoa(obj.nepaliTime.Hour())
-
-	if len(hour) < 2 {
-		hour = "0" + hour
-	}
-
-	return hour
+func (obj *NepaliFormatter) hour24(b []byte) []byte {
+	return appendPaddedInt(b, obj.nepaliTime.Hour(), 2)
 }
 
 // %-H
-func (obj *NepaliFormatter) hour24Nonzero() string {
-	return strconv.Itoa(obj.nepaliTime.Hour())
+func (obj *NepaliFormatter) hour24Nonzero(b []byte) []byte {
+	return strconv.AppendInt(b, int64(obj.nepaliTime.Hour()), 10)
 }
 
-// %I
-func (obj *NepaliFormatter) hour12() string {
+// converts the hour into 12-hour clock
+func (obj *NepaliFormatter) hour12Value() int {
 	hour := obj.nepaliTime.Hour()
 
 	if hour > 12 {
@@ -188,83 +220,122 @@ func (obj *NepaliFormatter) hour12() string {
 		hour = 12
 	}
 
-	hourStr := strconv.Itoa(hour)
-	if len(hourStr) < 2 {
-		hourStr = "0" + hourStr
-	}
+	return hour
+}
 
-	return hourStr
+// %I
+func (obj *NepaliFormatter) hour12(b []byte) []byte {
+	return appendPaddedInt(b, obj.hour12Value(), 2)
 }
 
 // %-I
-func (obj *NepaliFormatter) hour12Nonzero() string {
-	hour := obj.nepaliTime.Hour()
-
-	if hour > 12 {
-		hour -= 12
-	}
-	if hour == 0 {
-		hour = 12
-	}
-
-	return strconv.Itoa(hour)
+func (obj *NepaliFormatter) hour12Nonzero(b []byte) []byte {
+	return strconv.AppendInt(b, int64(obj.hour12Value()), 10)
 }
 
 // %p
-func (obj *NepaliFormatter) ampm() string {
-	ampm := "AM"
-
+func (obj *NepaliFormatter) ampm(b []byte) []byte {
 	if obj.nepaliTime.Hour() > 12 {
-		ampm = "PM"
+		return append(b, "PM"...)
 	}
 
-	return ampm
+	return append(b, "AM"...)
 }
 
 // %M
-func (obj *NepaliFormatter) minute() string {
-	minute := strconv.Itoa(obj.nepaliTime.Minute())
-
-	if len(minute) < 2 {
-		minute = "0" + minute
-	}
-
-	return minute
+func (obj *NepaliFormatter) minute(b []byte) []byte {
+	return appendPaddedInt(b, obj.nepaliTime.Minute(), 2)
 }
 
 // %-M
-func (obj *NepaliFormatter) minuteNonzero() string {
-	return strconv.Itoa(obj.nepaliTime.Minute())
+func (obj *NepaliFormatter) minuteNonzero(b []byte) []byte {
+	return strconv.AppendInt(b, int64(obj.nepaliTime.Minute()), 10)
 }
 
 // %s
-func (obj *NepaliFormatter) second() string {
-	second := strconv.Itoa(obj.nepaliTime.Second())
-
-	if len(second) < 2 {
-		second = "0" + second
-	}
-
-	return second
+func (obj *NepaliFormatter) second(b []byte) []byte {
+	return appendPaddedInt(b, obj.nepaliTime.Second(), 2)
 }
 
 // %-s
-func (obj *NepaliFormatter) secondNonzero() string {
-	return strconv.Itoa(obj.nepaliTime.Second())
+func (obj *NepaliFormatter) secondNonzero(b []byte) []byte {
+	return strconv.AppendInt(b, int64(obj.nepaliTime.Second()), 10)
 }
 
 // %f
-func (obj *NepaliFormatter) nanosecond_() string {
-	nsec := strconv.Itoa(obj.nepaliTime.Nanosecond())
+func (obj *NepaliFormatter) nanosecond_(b []byte) []byte {
+	return appendPaddedInt(b, obj.nepaliTime.Nanosecond(), 6)
+}
+
+// %-f
+func (obj *NepaliFormatter) nanosecondNonZero(b []byte) []byte {
+	return strconv.AppendInt(b, int64(obj.nepaliTime.Nanosecond()), 10)
+}
+
+// %z
+// offset of the time's location at that instant,
+// eg. +0545 for Asia/Kathmandu and +0530 before 1986
+func (obj *NepaliFormatter) utcOffset(b []byte) []byte {
+	return obj.nepaliTime.englishTime.AppendFormat(b, "-0700")
+}
+
+// %Z
+func (obj *NepaliFormatter) timezoneName(b []byte) []byte {
+	name, _ := obj.nepaliTime.englishTime.Zone()
 
-	if len(nsec) < 6 {
-		nsec = strings.Repeat("0", 6-len(nsec)) + nsec
+	return append(b, name...)
+}
+
+// CompiledFormat is a format string tokenized once,
+// so it can format many NepaliTime without parsing the format again.
+// A CompiledFormat is safe for concurrent use.
+//
+// USAGE:
+//
+//	var logFormat = nepalitime.CompileFormat("%Y-%m-%d %H:%M:%S")
+//	buf = logFormat.AppendFormat(buf, npTime)
+type CompiledFormat struct {
+	format string
+	tokens []formatToken
+}
+
+// CompileFormat tokenizes the format string.
+// Unknown directives are kept as it is, same as Format().
+func CompileFormat(format string) *CompiledFormat {
+	tokens := make([]formatToken, 0, len(format))
+	for index := 0; index < len(format); {
+		var token formatToken
+		token, index = nextFormatToken(format, index)
+
+		// joining consecutive literals
+		last := len(tokens) - 1
+		if token.directive == "" && last >= 0 && tokens[last].directive == "" {
+			tokens[last].literal += token.literal
+			continue
+		}
+		tokens = append(tokens, token)
 	}
 
-	return nsec
+	return &CompiledFormat{format: format, tokens: tokens}
 }
 
-// %-f
-func (obj *NepaliFormatter) nanosecondNonZero() string {
-	return strconv.Itoa(obj.nepaliTime.Nanosecond())
+// String returns the format string.
+func (obj *CompiledFormat) String() string {
+	return obj.format
+}
+
+// Format formats the nepali time, same as NepaliTime.Format().
+func (obj *CompiledFormat) Format(nepaliTime *NepaliTime) string {
+	return string(obj.AppendFormat(make([]byte, 0, len(obj.format)+16), nepaliTime))
+}
+
+// AppendFormat is like Format but appends the textual representation to b
+// and returns the extended buffer.
+func (obj *CompiledFormat) AppendFormat(b []byte, nepaliTime *NepaliTime) []byte {
+	formatter := NepaliFormatter{nepaliTime: nepaliTime}
+	for _, token := range obj.tokens {
+		b = formatter.appendToken(b, token)
+	}
+
+	return b
 }
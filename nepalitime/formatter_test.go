@@ -2,6 +2,7 @@ package nepalitime_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/opensource-nepal/go-nepali/nepalitime"
 	"github.com/stretchr/testify/assert"
@@ -324,3 +325,102 @@ func TestNepaliFormatterFormatHourGreaterThan12(t *testing.T) {
 
 	assert.Equal(t, "2079/11/04 1::10::11::000123", res, "%Y/%m/%d %-I::%M::%S::%f did not match")
 }
+
+func TestNepaliFormatterFormatUTCOffset(t *testing.T) {
+	formatter := nepalitime.NewFormatter(globalNepaliTime)
+	res := formatter.Format("%Y/%m/%d %H:%M %z %Z")
+
+	assert.Equal(t, "2079/10/14 16:23 +0545 +0545", res, "%z %Z did not match")
+}
+
+func TestNepaliFormatterFormatHistoricalUTCOffset(t *testing.T) {
+	// Nepal used +05:30 before 1986
+	date, _ := nepalitime.Date(2042, 9, 16, 23, 50, 0, 0)
+	formatter := nepalitime.NewFormatter(date)
+	res := formatter.Format("%Y/%m/%d %H:%M %z %Z")
+
+	assert.Equal(t, "2042/09/16 23:50 +0530 +0530", res, "%z %Z did not match")
+}
+
+func TestNepaliFormatterFormatUTCOffsetInOtherLocation(t *testing.T) {
+	date, _ := nepalitime.DateIn(2079, 10, 14, 16, 23, 17, 0, time.UTC)
+	formatter := nepalitime.NewFormatter(date)
+	res := formatter.Format("%z %Z")
+
+	assert.Equal(t, "+0000 UTC", res, "%z %Z did not match")
+}
+
+func TestNepaliTimeAppendFormat(t *testing.T) {
+	buf := []byte("date: ")
+	buf = globalNepaliTime.AppendFormat(buf, "%Y/%m/%d %H:%M:%S")
+
+	assert.Equal(t, "date: 2079/10/14 16:23:17", string(buf))
+}
+
+func TestNepaliTimeAppendFormatDoesNotAllocate(t *testing.T) {
+	buf := make([]byte, 0, 64)
+	allocs := testing.AllocsPerRun(100, func() {
+		buf = globalNepaliTime.AppendFormat(buf[:0], "%Y-%m-%d %H:%M:%S.%f %z %A %B")
+	})
+
+	assert.Equal(t, float64(0), allocs)
+}
+
+func TestCompiledFormatMatchesFormat(t *testing.T) {
+	formats := []string{
+		"%Y/%m/%d %H:%M:%S:%f",
+		"%-d %B, %Y, %a %-I:%-M %p",
+		"%y-%-m-%-d %-H:%-S:%-f %z %Z",
+		"%Y/$*#()%%%m/%d",
+		"%k %-k",
+		"trailing %",
+		"trailing %-",
+		"",
+	}
+
+	for _, format := range formats {
+		compiled := nepalitime.CompileFormat(format)
+		assert.Equal(t, format, compiled.String())
+		assert.Equal(t, globalNepaliTimeLeadingZeros.Format(format), compiled.Format(globalNepaliTimeLeadingZeros), format)
+	}
+}
+
+func TestCompiledFormatAppendFormat(t *testing.T) {
+	compiled := nepalitime.CompileFormat("%Y-%m-%d")
+	buf := compiled.AppendFormat([]byte("["), globalNepaliTime)
+
+	assert.Equal(t, "[2079-10-14", string(buf))
+}
+
+const benchmarkFormat = "%Y-%m-%d %H:%M:%S.%f %A"
+
+func BenchmarkFormat(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = globalNepaliTime.Format(benchmarkFormat)
+	}
+}
+
+func BenchmarkAppendFormat(b *testing.B) {
+	b.ReportAllocs()
+	buf := make([]byte, 0, 64)
+	for i := 0; i < b.N; i++ {
+		buf = globalNepaliTime.AppendFormat(buf[:0], benchmarkFormat)
+	}
+}
+
+func BenchmarkCompiledFormatAppendFormat(b *testing.B) {
+	b.ReportAllocs()
+	compiled := nepalitime.CompileFormat(benchmarkFormat)
+	buf := make([]byte, 0, 64)
+	for i := 0; i < b.N; i++ {
+		buf = compiled.AppendFormat(buf[:0], globalNepaliTime)
+	}
+}
+
+func TestNepaliFormatterFormatWeekdayNumberAndDayOfYear(t *testing.T) {
+	formatter := nepalitime.NewFormatter(globalNepaliTimeLeadingZeros)
+	res := formatter.Format("%w %j %-j")
+
+	assert.Equal(t, "5 002 2", res, "%w %j %-j did not match")
+}
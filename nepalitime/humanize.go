@@ -0,0 +1,179 @@
+package nepalitime
+
+import (
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/opensource-nepal/go-nepali/nepalinumber"
+)
+
+// Language of the humanized text
+type Language int
+
+const (
+	English Language = iota
+	Nepali           // devanagari script
+)
+
+// Thresholds decides the unit used to humanize a time difference.
+// A difference is shown in a unit while it is less than the unit's threshold,
+// else the next bigger unit is used.
+//
+// eg. with Hour: 22, a difference of 21 hours is "21 hours ago"
+// and a difference of 22 hours is "1 day ago".
+type Thresholds struct {
+	Second int // seconds before "just now" becomes minutes
+	Minute int // minutes before it becomes hours
+	Hour   int // hours before it becomes days
+	Day    int // days before it becomes months
+	Month  int // nepali months before it becomes years
+}
+
+// DefaultThresholds are used by NewHumanizer()
+var DefaultThresholds = Thresholds{Second: 45, Minute: 45, Hour: 22, Day: 26, Month: 11}
+
+// Humanizer converts time differences into phrases like "3 days ago" or "३ दिन अघि".
+//
+// Months and years are counted in the nepali calendar,
+// so the month lengths of 29 to 32 days are taken into account.
+type Humanizer struct {
+	Language   Language
+	Thresholds Thresholds
+}
+
+// NewHumanizer returns a Humanizer for the language with DefaultThresholds
+func NewHumanizer(language Language) *Humanizer {
+	return &Humanizer{Language: language, Thresholds: DefaultThresholds}
+}
+
+type humanizeUnit int
+
+const (
+	unitMinute humanizeUnit = iota
+	unitHour
+	unitDay
+	unitMonth
+	unitYear
+)
+
+var englishUnits = [...]string{"minute", "hour", "day", "month", "year"}
+var nepaliUnits = [...]string{"मिनेट", "घण्टा", "दिन", "महिना", "वर्ष"}
+
+// Since returns the humanized text of the time t relative to Now().
+// It is meant for past times, eg. "3 days ago".
+func (obj *Humanizer) Since(t *NepaliTime) string {
+	return obj.RelativeTo(t, Now())
+}
+
+// Until returns the humanized text of the time t relative to Now().
+// It is the same as Since(), the past and future times are both handled by either,
+// it only reads better for future times, eg. "in 2 months".
+func (obj *Humanizer) Until(t *NepaliTime) string {
+	return obj.RelativeTo(t, Now())
+}
+
+// RelativeTo returns the humanized text of the time t relative to the reference time ref.
+// eg. "3 days ago", "in 2 months", "just now"
+func (obj *Humanizer) RelativeTo(t, ref *NepaliTime) string {
+	diff := t.englishTime.Sub(*ref.englishTime)
+	future := diff > 0
+	if diff < 0 {
+		diff = -diff
+	}
+
+	earlier, later := t, ref
+	if future {
+		earlier, later = ref, t
+	}
+
+	th := obj.Thresholds
+	var (
+		unit   humanizeUnit
+		counts [unitYear + 1]int
+	)
+
+	seconds := roundDuration(diff, time.Second)
+	counts[unitMinute] = roundDuration(diff, time.Minute)
+	counts[unitHour] = roundDuration(diff, time.Hour)
+	counts[unitDay] = roundDuration(diff, 24*time.Hour)
+
+	switch {
+	case seconds < th.Second:
+		return obj.justNow()
+	case counts[unitMinute] < th.Minute:
+		unit = unitMinute
+	case counts[unitHour] < th.Hour:
+		unit = unitHour
+	case counts[unitDay] < th.Day:
+		unit = unitDay
+	default:
+		years, months, _ := Age(earlier.NepaliDate(), later.NepaliDate())
+		counts[unitMonth], counts[unitYear] = years*12+months, years
+		if counts[unitMonth] < th.Month {
+			unit = unitMonth
+		} else {
+			unit = unitYear
+		}
+	}
+
+	// the custom thresholds can select a unit too big for the difference,
+	// eg. 10 days with Day: 7 is 0 months, it falls back to the smaller units
+	for unit > unitMinute && counts[unit] < 1 {
+		unit--
+	}
+	count := max(counts[unit], 1)
+
+	return obj.phrase(unit, count, future)
+}
+
+func (obj *Humanizer) justNow() string {
+	if obj.Language == Nepali {
+		return "भर्खरै"
+	}
+	return "just now"
+}
+
+func (obj *Humanizer) phrase(unit humanizeUnit, count int, future bool) string {
+	if obj.Language == Nepali {
+		// nepali doesn't have plural form for the units
+		text := nepalinumber.ToDevanagari(strconv.Itoa(count)) + " " + nepaliUnits[unit]
+		if future {
+			return text + " पछि"
+		}
+		return text + " अघि"
+	}
+
+	text := strconv.Itoa(count) + " " + englishUnits[unit]
+	if count != 1 {
+		text += "s"
+	}
+	if future {
+		return "in " + text
+	}
+	return text + " ago"
+}
+
+// Since returns the humanized text in english relative to Now(), eg. "3 days ago".
+// Use Humanizer for nepali text and custom thresholds.
+func (obj *NepaliTime) Since() string {
+	return NewHumanizer(English).Since(obj)
+}
+
+// Until returns the humanized text in english relative to Now(), eg. "in 2 months".
+// It is the same as Since(), it only reads better for future times.
+// Use Humanizer for nepali text and custom thresholds.
+func (obj *NepaliTime) Until() string {
+	return NewHumanizer(English).Until(obj)
+}
+
+// RelativeTo returns the humanized text in english relative to the reference time.
+// Use Humanizer for nepali text and custom thresholds.
+func (obj *NepaliTime) RelativeTo(ref *NepaliTime) string {
+	return NewHumanizer(English).RelativeTo(obj, ref)
+}
+
+// rounds the duration to the nearest whole unit
+func roundDuration(d time.Duration, unit time.Duration) int {
+	return int(math.Round(float64(d) / float64(unit)))
+}
@@ -0,0 +1,109 @@
+package nepalitime_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/opensource-nepal/go-nepali/nepalitime"
+	"github.com/stretchr/testify/assert"
+)
+
+func mustFromEnglishTime(t *testing.T, enTime time.Time) *nepalitime.NepaliTime {
+	t.Helper()
+	nt, err := nepalitime.FromEnglishTime(enTime)
+	if err != nil {
+		t.Fatalf("invalid time %s: %s", enTime, err)
+	}
+	return nt
+}
+
+func TestRelativeToInEnglish(t *testing.T) {
+	ref := globalNepaliTime.GetEnglishTime()
+
+	testCases := []struct {
+		diff     time.Duration
+		expected string
+	}{
+		{0, "just now"},
+		{-44 * time.Second, "just now"},
+		{-50 * time.Second, "1 minute ago"},
+		{-5 * time.Minute, "5 minutes ago"},
+		{90 * time.Minute, "in 2 hours"},
+		{-21 * time.Hour, "21 hours ago"},
+		{-3 * 24 * time.Hour, "3 days ago"},
+		{25 * 24 * time.Hour, "in 25 days"},
+	}
+
+	for _, tc := range testCases {
+		nt := mustFromEnglishTime(t, ref.Add(tc.diff))
+		assert.Equal(t, tc.expected, nt.RelativeTo(globalNepaliTime), tc.diff.String())
+	}
+}
+
+func TestRelativeToCountsNepaliMonths(t *testing.T) {
+	ref, _ := nepalitime.Date(2079, 10, 14, 0, 0, 0, 0)
+
+	nt, _ := nepalitime.Date(2079, 8, 14, 0, 0, 0, 0)
+	assert.Equal(t, "2 months ago", nt.RelativeTo(ref))
+
+	nt, _ = nepalitime.Date(2079, 12, 20, 0, 0, 0, 0)
+	assert.Equal(t, "in 2 months", nt.RelativeTo(ref))
+
+	nt, _ = nepalitime.Date(2081, 10, 14, 0, 0, 0, 0)
+	assert.Equal(t, "in 2 years", nt.RelativeTo(ref))
+
+	nt, _ = nepalitime.Date(2078, 10, 14, 0, 0, 0, 0)
+	assert.Equal(t, "1 year ago", nt.RelativeTo(ref))
+}
+
+func TestRelativeToInNepali(t *testing.T) {
+	humanizer := nepalitime.NewHumanizer(nepalitime.Nepali)
+	ref := globalNepaliTime.GetEnglishTime()
+
+	nt := mustFromEnglishTime(t, ref.Add(-3*24*time.Hour))
+	assert.Equal(t, "३ दिन अघि", humanizer.RelativeTo(nt, globalNepaliTime))
+
+	nt, _ = nepalitime.Date(2079, 12, 20, 16, 23, 17, 0)
+	assert.Equal(t, "२ महिना पछि", humanizer.RelativeTo(nt, globalNepaliTime))
+
+	assert.Equal(t, "भर्खरै", humanizer.RelativeTo(globalNepaliTime, globalNepaliTime))
+}
+
+func TestRelativeToWithCustomThresholds(t *testing.T) {
+	humanizer := nepalitime.NewHumanizer(nepalitime.English)
+	humanizer.Thresholds.Day = 7
+
+	nt := mustFromEnglishTime(t, globalNepaliTime.GetEnglishTime().Add(-10*24*time.Hour))
+	assert.Equal(t, "10 days ago", humanizer.RelativeTo(nt, globalNepaliTime))
+
+	nt = mustFromEnglishTime(t, globalNepaliTime.GetEnglishTime().Add(-40*24*time.Hour))
+	assert.Equal(t, "1 month ago", humanizer.RelativeTo(nt, globalNepaliTime))
+
+	humanizer.Thresholds.Month = 6
+	ref, _ := nepalitime.Date(2079, 10, 14, 0, 0, 0, 0)
+	nt, _ = nepalitime.Date(2079, 2, 14, 0, 0, 0, 0)
+	assert.Equal(t, "8 months ago", humanizer.RelativeTo(nt, ref))
+
+	nt, _ = nepalitime.Date(2078, 2, 14, 0, 0, 0, 0)
+	assert.Equal(t, "1 year ago", humanizer.RelativeTo(nt, ref))
+}
+
+func TestSinceIsUntil(t *testing.T) {
+	defer nepalitime.SetClock(nepalitime.NewFakeClock(globalNepaliTime.GetEnglishTime()))()
+
+	past := mustFromEnglishTime(t, globalNepaliTime.GetEnglishTime().Add(-2*time.Hour))
+	assert.Equal(t, past.Since(), past.Until())
+
+	future := mustFromEnglishTime(t, globalNepaliTime.GetEnglishTime().Add(49*time.Hour))
+	assert.Equal(t, future.Since(), future.Until())
+}
+
+func TestSinceAndUntil(t *testing.T) {
+	defer nepalitime.SetClock(nepalitime.NewFakeClock(globalNepaliTime.GetEnglishTime()))()
+
+	past := mustFromEnglishTime(t, globalNepaliTime.GetEnglishTime().Add(-2*time.Hour))
+	assert.Equal(t, "2 hours ago", past.Since())
+
+	future := mustFromEnglishTime(t, globalNepaliTime.GetEnglishTime().Add(49*time.Hour))
+	assert.Equal(t, "in 2 days", future.Until())
+}
@@ -0,0 +1,178 @@
+package nepalitime
+
+import (
+	"container/list"
+	"regexp"
+	"sync"
+	"time"
+)
+
+// Layout is a compiled format string which can be reused to parse many
+// datetime strings without compiling the format again.
+// A Layout is safe for concurrent use.
+//
+// USAGE:
+//
+//	var dateLayout = nepalitime.MustCompileLayout("%Y/%m/%d")
+//	npTime, err := dateLayout.Parse("2079/10/14")
+type Layout struct {
+	format string
+	opts   ParseOptions
+	re     *regexp.Regexp
+}
+
+// CompileLayout compiles the format into a Layout.
+// Please see the directives section of README to know which directives are supported.
+func CompileLayout(format string) (*Layout, error) {
+	return CompileLayoutWithOptions(format, ParseOptions{})
+}
+
+// CompileLayoutWithOptions compiles the format into a Layout which parses with the options.
+func CompileLayoutWithOptions(format string, opts ParseOptions) (*Layout, error) {
+	re, err := getNepaliTimeReObject().compile(format, opts.Mode)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Layout{format: format, opts: opts, re: re}, nil
+}
+
+// MustCompileLayout is like CompileLayout but panics if the format is not supported.
+// It simplifies initialization of global variables holding layouts.
+func MustCompileLayout(format string) *Layout {
+	layout, err := CompileLayout(format)
+	if err != nil {
+		panic("nepalitime: MustCompileLayout(" + format + "): " + err.Error())
+	}
+
+	return layout
+}
+
+// String returns the format the layout was compiled from.
+func (obj *Layout) String() string {
+	return obj.format
+}
+
+// Parse parses the datetime string with the layout, same as Parse().
+func (obj *Layout) Parse(datetimeStr string) (*NepaliTime, error) {
+	return validate(datetimeStr, obj, obj.opts)
+}
+
+// ParseInLocation parses the datetime string with the layout, same as ParseInLocation().
+func (obj *Layout) ParseInLocation(datetimeStr string, loc *time.Location) (*NepaliTime, error) {
+	if loc == nil {
+		panic("nepalitime: missing Location in call to Layout.ParseInLocation")
+	}
+
+	opts := obj.opts
+	opts.Location = loc
+
+	return validate(datetimeStr, obj, opts)
+}
+
+// DefaultLayoutCacheSize is the number of layouts cached by Parse() by default.
+const DefaultLayoutCacheSize = 128
+
+// least recently used cache of the layouts compiled by Parse()
+type layoutCache struct {
+	mu       sync.Mutex
+	capacity int
+	// most recently used layout is at the front
+	order   *list.List
+	layouts map[layoutCacheKey]*list.Element
+}
+
+type layoutCacheKey struct {
+	format string
+	mode   ParseMode
+}
+
+func (obj *Layout) cacheKey() layoutCacheKey {
+	return layoutCacheKey{obj.format, obj.opts.Mode}
+}
+
+var parseLayoutCache = newLayoutCache(DefaultLayoutCacheSize)
+
+func newLayoutCache(capacity int) *layoutCache {
+	return &layoutCache{
+		capacity: capacity,
+		order:    list.New(),
+		layouts:  make(map[layoutCacheKey]*list.Element),
+	}
+}
+
+func (obj *layoutCache) get(key layoutCacheKey) (*Layout, bool) {
+	obj.mu.Lock()
+	defer obj.mu.Unlock()
+
+	element, ok := obj.layouts[key]
+	if !ok {
+		return nil, false
+	}
+	obj.order.MoveToFront(element)
+
+	return element.Value.(*Layout), true
+}
+
+func (obj *layoutCache) add(layout *Layout) {
+	obj.mu.Lock()
+	defer obj.mu.Unlock()
+
+	if element, ok := obj.layouts[layout.cacheKey()]; ok {
+		obj.order.MoveToFront(element)
+		return
+	}
+
+	obj.layouts[layout.cacheKey()] = obj.order.PushFront(layout)
+	obj.evict()
+}
+
+func (obj *layoutCache) resize(capacity int) {
+	obj.mu.Lock()
+	defer obj.mu.Unlock()
+
+	obj.capacity = capacity
+	obj.evict()
+}
+
+func (obj *layoutCache) len() int {
+	obj.mu.Lock()
+	defer obj.mu.Unlock()
+
+	return obj.order.Len()
+}
+
+// removes the least recently used layouts over the capacity,
+// should be called with the lock held
+func (obj *layoutCache) evict() {
+	for obj.order.Len() > obj.capacity {
+		element := obj.order.Back()
+		obj.order.Remove(element)
+		delete(obj.layouts, element.Value.(*Layout).cacheKey())
+	}
+}
+
+// SetLayoutCacheSize sets the number of layouts cached by Parse().
+// A size of 0 disables the cache.
+func SetLayoutCacheSize(size int) {
+	if size < 0 {
+		size = 0
+	}
+	parseLayoutCache.resize(size)
+}
+
+// returns the compiled layout of the format from the cache,
+// compiling and caching it if not present
+func getCachedLayout(format string, mode ParseMode) (*Layout, error) {
+	if layout, ok := parseLayoutCache.get(layoutCacheKey{format, mode}); ok {
+		return layout, nil
+	}
+
+	layout, err := CompileLayoutWithOptions(format, ParseOptions{Mode: mode})
+	if err != nil {
+		return nil, err
+	}
+	parseLayoutCache.add(layout)
+
+	return layout, nil
+}
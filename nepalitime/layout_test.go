@@ -0,0 +1,104 @@
+package nepalitime_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/opensource-nepal/go-nepali/nepalitime"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompileLayout(t *testing.T) {
+	layout, err := nepalitime.CompileLayout("%Y/%m/%d")
+	assert.Nil(t, err)
+	assert.Equal(t, "%Y/%m/%d", layout.String())
+
+	got, err := layout.Parse("2079/10/14")
+	assert.Nil(t, err)
+	assert.Equal(t, "2079-10-14 00:00:00", got.String())
+}
+
+func TestCompileLayoutWithUnsupportedDirective(t *testing.T) {
+	layout, err := nepalitime.CompileLayout("%Y/%k")
+	assert.Nil(t, layout)
+	assert.NotNil(t, err)
+}
+
+func TestMustCompileLayoutPanicsOnUnsupportedDirective(t *testing.T) {
+	assert.Panics(t, func() { nepalitime.MustCompileLayout("%Y/%k") })
+}
+
+func TestLayoutParseInLocation(t *testing.T) {
+	layout := nepalitime.MustCompileLayout("%Y/%m/%d %H:%M")
+
+	got, err := layout.ParseInLocation("2079/10/14 10:30", time.UTC)
+	assert.Nil(t, err)
+	assert.Equal(t, time.Date(2023, 1, 28, 10, 30, 0, 0, time.UTC), got.GetEnglishTime())
+}
+
+func TestLayoutParseWithMismatchedString(t *testing.T) {
+	layout := nepalitime.MustCompileLayout("%Y/%m/%d")
+
+	got, err := layout.Parse("2079-10-14")
+	assert.Nil(t, got)
+	assert.NotNil(t, err)
+}
+
+func TestLayoutParseConcurrently(t *testing.T) {
+	layout := nepalitime.MustCompileLayout("%Y/%m/%d")
+
+	var wg sync.WaitGroup
+	for i := 1; i <= 20; i++ {
+		wg.Add(1)
+		go func(day int) {
+			defer wg.Done()
+
+			str := fmt.Sprintf("2079/10/%02d", day)
+			got, err := layout.Parse(str)
+			assert.Nil(t, err)
+			assert.Equal(t, day, got.Day())
+
+			// uses the shared layout cache
+			got, err = nepalitime.Parse(str, "%Y/%m/%d")
+			assert.Nil(t, err)
+			assert.Equal(t, day, got.Day())
+		}(i)
+	}
+	wg.Wait()
+}
+
+func TestParseLayoutCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	defer nepalitime.SetLayoutCacheSize(nepalitime.DefaultLayoutCacheSize)
+
+	nepalitime.SetLayoutCacheSize(2)
+	assert.LessOrEqual(t, nepalitime.LayoutCacheLen(), 2)
+
+	for _, format := range []string{"%Y/%m/%d", "%Y-%m-%d", "%d/%m/%Y"} {
+		_, _ = nepalitime.Parse("2079/10/14", format)
+	}
+	assert.Equal(t, 2, nepalitime.LayoutCacheLen())
+
+	nepalitime.SetLayoutCacheSize(0)
+	assert.Equal(t, 0, nepalitime.LayoutCacheLen())
+
+	// parsing works without cache
+	got, err := nepalitime.Parse("2079/10/14", "%Y/%m/%d")
+	assert.Nil(t, err)
+	assert.Equal(t, "2079-10-14 00:00:00", got.String())
+	assert.Equal(t, 0, nepalitime.LayoutCacheLen())
+}
+
+func BenchmarkParse(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_, _ = nepalitime.Parse("2079/10/14 16:23:17", "%Y/%m/%d %H:%M:%S")
+	}
+}
+
+func BenchmarkLayoutParse(b *testing.B) {
+	layout := nepalitime.MustCompileLayout("%Y/%m/%d %H:%M:%S")
+	for i := 0; i < b.N; i++ {
+		_, _ = layout.Parse("2079/10/14 16:23:17")
+	}
+}
@@ -0,0 +1,148 @@
+package nepalitime
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/opensource-nepal/go-nepali/dateConverter"
+)
+
+// NepaliDate represents a nepali (BS) calendar date without the time of day.
+// It is a small comparable value so it can be used as a map key.
+//
+// The zero value is not a valid date, use NewDate() or NepaliTime.NepaliDate().
+type NepaliDate struct {
+	year  int
+	month int
+	day   int
+}
+
+// NewDate returns the NepaliDate for the given year, month and day.
+// Returns error if the date doesn't exist or is out of range.
+func NewDate(year, month, day int) (NepaliDate, error) {
+	if !dateConverter.IsValidNepaliDate(year, month, day) {
+		return NepaliDate{}, dateConverter.ErrOutOfRange
+	}
+	return NepaliDate{year, month, day}, nil
+}
+
+// String returns the date in the form "2079-10-06"
+func (d NepaliDate) String() string {
+	return fmt.Sprintf("%d-%s-%s", d.year, twoDigitNumber(d.month), twoDigitNumber(d.day))
+}
+
+// Date returns the year, month, and day
+func (d NepaliDate) Date() (year, month, day int) {
+	return d.year, d.month, d.day
+}
+
+// Year returns the year of the date.
+func (d NepaliDate) Year() int {
+	return d.year
+}
+
+// Month returns the month of the year.
+func (d NepaliDate) Month() int {
+	return d.month
+}
+
+// Day returns the day of the month.
+func (d NepaliDate) Day() int {
+	return d.day
+}
+
+// Weekday returns the day of the week.
+func (d NepaliDate) Weekday() time.Weekday {
+	return d.englishDate().Weekday()
+}
+
+// YearDay returns the day of the year, in the range [1, 365] or [1, 366].
+func (d NepaliDate) YearDay() int {
+	return d.Sub(NepaliDate{d.year, 1, 1}) + 1
+}
+
+// DaysInMonth returns the number of days (29 to 32) in the month of the date.
+func (d NepaliDate) DaysInMonth() int {
+	days, _ := dateConverter.NepaliMonthDays(d.year, d.month)
+	return days
+}
+
+// Time returns the NepaliTime at the start of the day.
+func (d NepaliDate) Time() *NepaliTime {
+	nt, _ := Date(d.year, d.month, d.day, 0, 0, 0, 0)
+	return nt
+}
+
+// AddDays returns the date n days after d (or before d for negative n).
+// Returns error if the result is out of the supported range.
+func (d NepaliDate) AddDays(n int) (NepaliDate, error) {
+	enDate := d.englishDate().AddDate(0, 0, n)
+	npDate, err := dateConverter.EnglishToNepali(enDate.Year(), int(enDate.Month()), enDate.Day())
+	if err != nil {
+		return NepaliDate{}, err
+	}
+	return NepaliDate{npDate[0], npDate[1], npDate[2]}, nil
+}
+
+// AddMonths returns the date n nepali months after d (or before d for negative n).
+// The day is clamped to the length of the resulting month, eg. 2079-01-32 + 1 month is 2079-02-31.
+// Returns error if the result is out of the supported range.
+func (d NepaliDate) AddMonths(n int) (NepaliDate, error) {
+	year, month := addMonths(d.year, d.month, n)
+	days, err := dateConverter.NepaliMonthDays(year, month)
+	if err != nil {
+		return NepaliDate{}, err
+	}
+	return NepaliDate{year, month, min(d.day, days)}, nil
+}
+
+// Sub returns the number of days between u and d (d - u).
+func (d NepaliDate) Sub(u NepaliDate) int {
+	return int(d.englishDate().Sub(u.englishDate()).Hours() / 24)
+}
+
+// Compare returns -1 if d is before u, +1 if d is after u and 0 if they are same.
+func (d NepaliDate) Compare(u NepaliDate) int {
+	switch {
+	case d.year != u.year:
+		return sign(d.year - u.year)
+	case d.month != u.month:
+		return sign(d.month - u.month)
+	default:
+		return sign(d.day - u.day)
+	}
+}
+
+// Before reports whether the date d is before u.
+func (d NepaliDate) Before(u NepaliDate) bool {
+	return d.Compare(u) < 0
+}
+
+// After reports whether the date d is after u.
+func (d NepaliDate) After(u NepaliDate) bool {
+	return d.Compare(u) > 0
+}
+
+// Equal reports whether d and u represent the same date.
+func (d NepaliDate) Equal(u NepaliDate) bool {
+	return d == u
+}
+
+// english date in UTC, used for day calculations
+func (d NepaliDate) englishDate() time.Time {
+	enDate, _ := dateConverter.NepaliToEnglish(d.year, d.month, d.day)
+	if enDate == nil {
+		return time.Time{}
+	}
+	return time.Date(enDate[0], time.Month(enDate[1]), enDate[2], 0, 0, 0, 0, time.UTC)
+}
+
+func sign(n int) int {
+	if n < 0 {
+		return -1
+	}
+	if n > 0 {
+		return 1
+	}
+	return 0
+}
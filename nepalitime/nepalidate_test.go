@@ -0,0 +1,110 @@
+package nepalitime_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/opensource-nepal/go-nepali/dateConverter"
+	"github.com/opensource-nepal/go-nepali/nepalitime"
+	"github.com/stretchr/testify/assert"
+)
+
+func mustDate(t *testing.T, year, month, day int) nepalitime.NepaliDate {
+	t.Helper()
+	d, err := nepalitime.NewDate(year, month, day)
+	if err != nil {
+		t.Fatalf("invalid date %d-%d-%d: %s", year, month, day, err)
+	}
+	return d
+}
+
+func TestNewDateWithValidDate(t *testing.T) {
+	d, err := nepalitime.NewDate(2079, 10, 14)
+	assert.Nil(t, err)
+	y, m, day := d.Date()
+	assert.Equal(t, 2079, y)
+	assert.Equal(t, 10, m)
+	assert.Equal(t, 14, day)
+}
+
+func TestNewDateWithDay32(t *testing.T) {
+	_, err := nepalitime.NewDate(2079, 3, 32)
+	assert.Nil(t, err)
+
+	_, err = nepalitime.NewDate(2079, 1, 32)
+	assert.ErrorIs(t, err, dateConverter.ErrOutOfRange)
+}
+
+func TestNepaliDateString(t *testing.T) {
+	assert.Equal(t, "2079-01-04", mustDate(t, 2079, 1, 4).String())
+}
+
+func TestNepaliDateWeekday(t *testing.T) {
+	assert.Equal(t, time.Saturday, mustDate(t, 2079, 10, 14).Weekday())
+}
+
+func TestNepaliDateDaysInMonth(t *testing.T) {
+	assert.Equal(t, 32, mustDate(t, 2079, 3, 1).DaysInMonth())
+	assert.Equal(t, 31, mustDate(t, 2080, 3, 1).DaysInMonth())
+}
+
+func TestNepaliDateTime(t *testing.T) {
+	assert.Equal(t, "2079-10-14 00:00:00", mustDate(t, 2079, 10, 14).Time().String())
+}
+
+func TestNepaliTimeNepaliDate(t *testing.T) {
+	assert.Equal(t, mustDate(t, 2079, 10, 14), globalNepaliTime.NepaliDate())
+}
+
+func TestNepaliDateAddDaysAcrossYear(t *testing.T) {
+	d, err := mustDate(t, 2079, 12, 30).AddDays(1)
+	assert.Nil(t, err)
+	assert.Equal(t, "2080-01-01", d.String())
+
+	d, err = d.AddDays(-1)
+	assert.Nil(t, err)
+	assert.Equal(t, "2079-12-30", d.String())
+}
+
+func TestNepaliDateAddDaysOutOfRange(t *testing.T) {
+	_, err := mustDate(t, 2000, 1, 1).AddDays(-1)
+	assert.NotNil(t, err)
+}
+
+func TestNepaliDateAddMonths(t *testing.T) {
+	d, err := mustDate(t, 2079, 10, 14).AddMonths(3)
+	assert.Nil(t, err)
+	assert.Equal(t, "2080-01-14", d.String())
+
+	d, err = d.AddMonths(-13)
+	assert.Nil(t, err)
+	assert.Equal(t, "2078-12-14", d.String())
+
+	// the day is clamped to the length of the month
+	d, err = mustDate(t, 2079, 3, 32).AddMonths(1)
+	assert.Nil(t, err)
+	assert.Equal(t, "2079-04-31", d.String())
+}
+
+func TestNepaliDateAddMonthsOutOfRange(t *testing.T) {
+	_, err := mustDate(t, 2000, 1, 1).AddMonths(-1)
+	assert.NotNil(t, err)
+}
+
+func TestNepaliDateSub(t *testing.T) {
+	assert.Equal(t, 366, mustDate(t, 2082, 1, 1).Sub(mustDate(t, 2081, 1, 1)))
+	assert.Equal(t, -365, mustDate(t, 2079, 1, 1).Sub(mustDate(t, 2080, 1, 1)))
+}
+
+func TestNepaliDateCompare(t *testing.T) {
+	a, b := mustDate(t, 2079, 10, 14), mustDate(t, 2079, 11, 1)
+	assert.True(t, a.Before(b))
+	assert.True(t, b.After(a))
+	assert.False(t, a.Equal(b))
+	assert.Equal(t, 0, a.Compare(a))
+}
+
+func TestNepaliDateYearDay(t *testing.T) {
+	assert.Equal(t, 1, mustDate(t, 2081, 1, 1).YearDay())
+	assert.Equal(t, 366, mustDate(t, 2081, 12, 31).YearDay())
+}
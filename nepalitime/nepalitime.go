@@ -18,6 +18,7 @@ type NepaliTime struct {
 }
 
 // String returns a string representing the duration in the form "2079-10-06 01:00:05"
+// It is also used for %+v, use Printable() to print the AD time and the zone with %+v.
 func (obj *NepaliTime) String() string {
 	h, m, s := obj.Clock()
 	return fmt.Sprintf(
@@ -41,6 +42,11 @@ func (obj *NepaliTime) Date() (year, month, day int) {
 	return obj.year, obj.month, obj.day
 }
 
+// NepaliDate returns the nepali date without the time of day.
+func (obj *NepaliTime) NepaliDate() NepaliDate {
+	return NepaliDate{obj.year, obj.month, obj.day}
+}
+
 // Year returns the year in which nepalitime occurs.
 func (obj *NepaliTime) Year() int {
 	return obj.year
@@ -64,6 +70,11 @@ func (obj *NepaliTime) Weekday() time.Weekday {
 	return obj.englishTime.Weekday()
 }
 
+// YearDay returns the day of the nepali year, in the range [1, 365] or [1, 366].
+func (obj *NepaliTime) YearDay() int {
+	return obj.NepaliDate().YearDay()
+}
+
 // Clock returns the hour, minute, and second of the day.
 func (obj *NepaliTime) Clock() (hour, min, sec int) {
 	return obj.englishTime.Clock()
@@ -96,3 +107,11 @@ func (obj *NepaliTime) Format(format string) string {
 
 	return formatter.Format(format)
 }
+
+// AppendFormat is like Format but appends the textual representation to b
+// and returns the extended buffer.
+func (obj *NepaliTime) AppendFormat(b []byte, format string) []byte {
+	formatter := NepaliFormatter{nepaliTime: obj}
+
+	return formatter.AppendFormat(b, format)
+}
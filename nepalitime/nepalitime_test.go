@@ -98,3 +98,8 @@ func TestNepaliTimeFormatReturnAsItIsOnUnknownFormat(t *testing.T) {
 
 	assert.Equal(t, "%k", res, "Unknown format didn't returned as it is")
 }
+
+func TestNepaliTimeYearDay(t *testing.T) {
+	assert.Equal(t, 290, globalNepaliTime.YearDay())
+	assert.Equal(t, 2, globalNepaliTimeLeadingZeros.YearDay())
+}
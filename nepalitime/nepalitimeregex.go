@@ -4,11 +4,17 @@ package nepalitime
 import (
 	"fmt"
 	"regexp"
+	"sort"
 	"strings"
+	"unicode"
+
+	"github.com/opensource-nepal/go-nepali/constants"
 )
 
 type nepaliTimeRegex struct {
 	PatternMap map[string]string
+	// patterns with exact widths, used in StrictMode over PatternMap
+	StrictPatternMap map[string]string
 }
 
 // nepaliTimeRe constructor
@@ -24,6 +30,7 @@ func newNepaliTimeRegex() *nepaliTimeRegex {
 		"-I": `(?P<I>1[0-2]|0[1-9]|[1-9])`,
 		"G":  `(?P<G>\d\d\d\d)`,
 		"j":  `(?P<j>36[0-6]|3[0-5]\d|[1-2]\d\d|0[1-9]\d|00[1-9]|[1-9]\d|0[1-9]|[1-9])`,
+		"-j": `(?P<j>36[0-6]|3[0-5]\d|[1-2]\d\d|[1-9]\d|[1-9])`,
 		"m":  `(?P<m>1[0-2]|0[1-9]|[1-9])`,
 		"-m": `(?P<m>1[0-2]|0[1-9]|[1-9])`, // same as "m"
 		"M":  `(?P<M>[0-5]\d|\d)`,
@@ -35,8 +42,9 @@ func newNepaliTimeRegex() *nepaliTimeRegex {
 		"y": `(?P<y>\d\d)`,
 		"Y": `(?P<Y>\d\d\d\d)`,
 		"z": `(?P<z>[+-]\d\d:?[0-5]\d(:?[0-5]\d(\.\d{1,6})?)?|(?-i:Z))`,
-		"B": `(?P<B>Baisakh|Jestha|Ashadh|Shrawan|Bhadra|Ashwin|Kartik|Mangsir|Poush|Magh|Falgun|Chaitra")`,
-		"A": `(?P<B>Sunday|Monday|Tuesday|Wednesday|Thursday|Friday|Saturday)`,
+		"B": monthNamePattern(),
+		"A": `(?P<A>Sunday|Monday|Tuesday|Wednesday|Thursday|Friday|Saturday)`,
+		"a": `(?P<a>Sun|Mon|Tue|Wed|Thu|Fri|Sat)`,
 		// "b": obj.__seqToRE(EnglishChar.months, "b"),
 		// "p": obj.__seqToRE(("AM", "PM",), "p"),
 		// TODO: implement for the above commented directives
@@ -44,17 +52,52 @@ func newNepaliTimeRegex() *nepaliTimeRegex {
 
 		"%": "%",
 	}
+	obj.StrictPatternMap = map[string]string{
+		"d":  `(?P<d>3[0-2]|[1-2]\d|0[1-9])`,
+		"-d": `(?P<d>3[0-2]|[1-2]\d|[1-9])`,
+		"f":  `(?P<f>[0-9]{6})`,
+		"H":  `(?P<H>2[0-3]|[0-1]\d)`,
+		"-H": `(?P<H>2[0-3]|1\d|\d)`,
+		"I":  `(?P<I>1[0-2]|0[1-9])`,
+		"-I": `(?P<I>1[0-2]|[1-9])`,
+		"m":  `(?P<m>1[0-2]|0[1-9])`,
+		"-m": `(?P<m>1[0-2]|[1-9])`,
+		"j":  `(?P<j>36[0-6]|3[0-5]\d|[1-2]\d\d|0[1-9]\d|00[1-9])`,
+		"M":  `(?P<M>[0-5]\d)`,
+		"-M": `(?P<M>[1-5]\d|\d)`,
+		// leap seconds are not accepted
+		"S":  `(?P<S>[0-5]\d)`,
+		"-S": `(?P<S>[1-5]\d|\d)`,
+	}
 
 	return obj
 }
 
+// regex of the english and devanagari month names
+func monthNamePattern() string {
+	var names []string
+	names = append(names, constants.NepaliMonths[:]...)
+	names = append(names, constants.DevanagariMonths[:]...)
+	for alias := range devanagariMonthAliases {
+		names = append(names, alias)
+	}
+	// longer names first, so a name isn't matched by its prefix
+	sort.Slice(names, func(i, j int) bool { return len(names[i]) > len(names[j]) })
+
+	return `(?P<B>` + strings.Join(names, "|") + `)`
+}
+
+var (
+	regexChars            = regexp.MustCompile(`([\.^$*+?\(\){}\[\]|])`)
+	whitespaceReplacement = regexp.MustCompile(`\s+`)
+)
+
+// separators which are optional and interchangeable in LenientMode
+const lenientSeparators = `[\s/\-.,:_]*`
+
 // Handles conversion from format directives to regexes
-func (obj *nepaliTimeRegex) pattern(format string) (string, error) {
-	processedFormat := ""
-	regexChars := regexp.MustCompile(`([\.^$*+?\(\){}\[\]|])`)
-	format = regexChars.ReplaceAllString(format, `\$1`)
-	whitespaceReplacement := regexp.MustCompile(`\s+`)
-	format = whitespaceReplacement.ReplaceAllString(format, `\s+`)
+func (obj *nepaliTimeRegex) pattern(format string, mode ParseMode) (string, error) {
+	var processedFormat strings.Builder
 
 	for {
 		index := strings.Index(format, "%")
@@ -64,38 +107,88 @@ func (obj *nepaliTimeRegex) pattern(format string) (string, error) {
 			break
 		}
 
+		processedFormat.WriteString(literalPattern(format[:index], mode))
+
 		directiveIndex := index + 1
 		indexIncrement := 1
 
-		if string(format[directiveIndex]) == "-" {
+		if directiveIndex < len(format) && format[directiveIndex] == '-' {
 			indexIncrement = 2
 		}
+		if directiveIndex+indexIncrement > len(format) {
+			return "", fmt.Errorf("the format '%%%s' isn't supported", format[directiveIndex:])
+		}
 
-		directiveToCheck := string(format[directiveIndex : directiveIndex+indexIncrement])
+		directiveToCheck := format[directiveIndex : directiveIndex+indexIncrement]
 
-		if val, ok := obj.PatternMap[directiveToCheck]; ok {
-			processedFormat = fmt.Sprintf("%s%s%s", processedFormat, string(format[:directiveIndex-1]), val)
-			format = string(format[directiveIndex+indexIncrement:])
-		} else {
+		val, ok := obj.directivePattern(directiveToCheck, mode)
+		if !ok {
 			return "", fmt.Errorf("the format '%%%s' isn't supported", directiveToCheck)
 		}
+		processedFormat.WriteString(val)
+		format = format[directiveIndex+indexIncrement:]
 	}
+	processedFormat.WriteString(literalPattern(format, mode))
 
-	tester := fmt.Sprintf("^%s%s$", processedFormat, format)
+	return fmt.Sprintf("^%s$", processedFormat.String()), nil
+}
+
+// returns the regex of the directive for the mode
+func (obj *nepaliTimeRegex) directivePattern(directive string, mode ParseMode) (string, bool) {
+	if mode == StrictMode {
+		if val, ok := obj.StrictPatternMap[directive]; ok {
+			return val, true
+		}
+	}
+	if val, ok := obj.PatternMap[directive]; ok {
+		return val, true
+	}
 
-	return tester, nil
+	return customDirectivePattern(directive)
+}
+
+// converts the text between directives into regex
+func literalPattern(literal string, mode ParseMode) string {
+	switch mode {
+	case StrictMode:
+		return regexp.QuoteMeta(literal)
+	case LenientMode:
+		var builder strings.Builder
+		separator := false
+		for _, char := range literal {
+			if unicode.IsSpace(char) || strings.ContainsRune("/-.,:_", char) {
+				separator = true
+				continue
+			}
+			if separator {
+				builder.WriteString(lenientSeparators)
+				separator = false
+			}
+			builder.WriteString(regexp.QuoteMeta(string(char)))
+		}
+		if separator {
+			builder.WriteString(lenientSeparators)
+		}
+		return builder.String()
+	default:
+		literal = regexChars.ReplaceAllString(literal, `\$1`)
+		return whitespaceReplacement.ReplaceAllString(literal, `\s+`)
+	}
 }
 
 // handles regex compilation for format string
-func (obj *nepaliTimeRegex) compile(format string) (*regexp.Regexp, error) {
-	processedFormat, err := obj.pattern(format)
+func (obj *nepaliTimeRegex) compile(format string, mode ParseMode) (*regexp.Regexp, error) {
+	processedFormat, err := obj.pattern(format, mode)
 
 	if err != nil {
 		return nil, err
 	}
 
 	// (?i) is for ignoring the case
-	reg, err := regexp.Compile("(?i)" + processedFormat)
+	if mode != StrictMode {
+		processedFormat = "(?i)" + processedFormat
+	}
+	reg, err := regexp.Compile(processedFormat)
 
 	if err != nil {
 		return nil, err
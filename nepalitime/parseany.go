@@ -0,0 +1,110 @@
+package nepalitime
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// DefaultAnyLayouts are the layouts tried by ParseAny(), in the order of priority.
+var DefaultAnyLayouts = []string{
+	"%Y-%m-%d %H:%M:%S",
+	"%Y/%m/%d %H:%M:%S",
+	"%Y-%m-%d %H:%M",
+	"%Y/%m/%d %H:%M",
+	"%Y-%m-%d",
+	"%Y/%m/%d",
+	"%Y.%m.%d",
+	"%d-%m-%Y",
+	"%d/%m/%Y",
+	"%d.%m.%Y",
+	"%m-%d-%Y",
+	"%m/%d/%Y",
+	"%m.%d.%Y",
+	"%d %B %Y",
+	"%d %B, %Y",
+	"%B %d, %Y",
+	"%B %d %Y",
+	"%Y %B %d",
+}
+
+// AmbiguousError is returned by ParseAny() when the datetime string matches
+// layouts which give different times, eg. "05/06/2079" as day/month or month/day.
+type AmbiguousError struct {
+	Value   string
+	Layouts []string // matched layouts, in the order of priority
+}
+
+func (e *AmbiguousError) Error() string {
+	return fmt.Sprintf("ambiguous datetime %q, matches the layouts %q", e.Value, strings.Join(e.Layouts, `", "`))
+}
+
+// AnyParser parses datetime strings of unknown layout by trying the layouts in order.
+//
+// USAGE:
+//
+//	parser := nepalitime.NewAnyParser()
+//	parser.Layouts = append(parser.Layouts, "%Y%m%d")
+//	npTime, layout, err := parser.Parse("2079/10/14")
+type AnyParser struct {
+	// Layouts are tried in the order of priority
+	Layouts []string
+
+	// Options used to parse with each layout
+	Options ParseOptions
+
+	// AllowAmbiguous returns the time of the first matched layout for an
+	// ambiguous datetime string instead of an AmbiguousError.
+	AllowAmbiguous bool
+}
+
+// NewAnyParser returns an AnyParser with DefaultAnyLayouts.
+func NewAnyParser() *AnyParser {
+	return &AnyParser{Layouts: append([]string(nil), DefaultAnyLayouts...)}
+}
+
+// ParseAny parses the datetime string with the first matching layout of DefaultAnyLayouts.
+// It accepts devanagari digits and month names, eg. "१४ माघ २०७९".
+//
+// Returns the parsed time and the matched layout.
+// Returns *AmbiguousError if the string matches layouts which give different times.
+func ParseAny(datetimeStr string) (*NepaliTime, string, error) {
+	return NewAnyParser().Parse(datetimeStr)
+}
+
+// Parse parses the datetime string with the first matching layout.
+// Returns the parsed time and the matched layout, see ParseAny().
+func (obj *AnyParser) Parse(datetimeStr string) (*NepaliTime, string, error) {
+	var (
+		result  *NepaliTime
+		matched []string
+		differs bool
+	)
+
+	for _, layout := range obj.Layouts {
+		nepaliTime, err := ParseWithOptions(datetimeStr, layout, obj.Options)
+		if err != nil {
+			continue
+		}
+
+		if result == nil {
+			result = nepaliTime
+		} else if !nepaliTime.englishTime.Equal(*result.englishTime) {
+			differs = true
+		}
+		matched = append(matched, layout)
+
+		if obj.AllowAmbiguous {
+			break
+		}
+	}
+
+	if result == nil {
+		return nil, "", errors.New("datetime string did not match with any layout")
+	}
+	if differs {
+		return nil, "", &AmbiguousError{Value: datetimeStr, Layouts: matched}
+	}
+
+	return result, matched[0], nil
+}
@@ -0,0 +1,94 @@
+package nepalitime_test
+
+import (
+	"testing"
+
+	"github.com/opensource-nepal/go-nepali/nepalitime"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAny(t *testing.T) {
+	testCases := []struct {
+		datetimeStr string
+		layout      string
+	}{
+		{"2079/10/14", "%Y/%m/%d"},
+		{"2079-10-14", "%Y-%m-%d"},
+		{"2079.10.14", "%Y.%m.%d"},
+		{"14-10-2079", "%d-%m-%Y"},
+		{"14/10/2079", "%d/%m/%Y"},
+		{"10/14/2079", "%m/%d/%Y"},
+		{"14 Magh 2079", "%d %B %Y"},
+		{"Magh 14, 2079", "%B %d, %Y"},
+		{"१४ माघ २०७९", "%d %B %Y"},
+		{"२०७९/१०/१४", "%Y/%m/%d"},
+	}
+
+	for _, tc := range testCases {
+		got, layout, err := nepalitime.ParseAny(tc.datetimeStr)
+		if assert.Nil(t, err, tc.datetimeStr) {
+			assert.Equal(t, "2079-10-14 00:00:00", got.String(), tc.datetimeStr)
+			assert.Equal(t, tc.layout, layout, tc.datetimeStr)
+		}
+	}
+}
+
+func TestParseAnyWithTime(t *testing.T) {
+	got, layout, err := nepalitime.ParseAny("2079-10-14 16:23:17")
+
+	assert.Nil(t, err, "error should be nil")
+	assert.Equal(t, "2079-10-14 16:23:17", got.String())
+	assert.Equal(t, "%Y-%m-%d %H:%M:%S", layout)
+}
+
+func TestParseAnyWithAmbiguousDayAndMonth(t *testing.T) {
+	got, layout, err := nepalitime.ParseAny("05/06/2079")
+
+	assert.Nil(t, got)
+	assert.Equal(t, "", layout)
+
+	var ambiguousErr *nepalitime.AmbiguousError
+	if assert.ErrorAs(t, err, &ambiguousErr) {
+		assert.Equal(t, []string{"%d/%m/%Y", "%m/%d/%Y"}, ambiguousErr.Layouts)
+	}
+}
+
+func TestParseAnyWithSameDayAndMonthIsNotAmbiguous(t *testing.T) {
+	got, layout, err := nepalitime.ParseAny("06/06/2079")
+
+	assert.Nil(t, err, "error should be nil")
+	assert.Equal(t, "2079-06-06 00:00:00", got.String())
+	assert.Equal(t, "%d/%m/%Y", layout)
+}
+
+func TestParseAnyWithUnknownLayout(t *testing.T) {
+	got, _, err := nepalitime.ParseAny("14th of Magh")
+
+	assert.Nil(t, got)
+	assert.NotNil(t, err)
+}
+
+func TestAnyParserAllowAmbiguous(t *testing.T) {
+	parser := nepalitime.NewAnyParser()
+	parser.AllowAmbiguous = true
+
+	got, layout, err := parser.Parse("05/06/2079")
+	assert.Nil(t, err, "error should be nil")
+	assert.Equal(t, "2079-06-05 00:00:00", got.String())
+	assert.Equal(t, "%d/%m/%Y", layout)
+}
+
+func TestAnyParserWithCustomLayouts(t *testing.T) {
+	parser := nepalitime.NewAnyParser()
+	parser.Layouts = []string{"%m/%d/%Y", "%Y%m%d"}
+
+	got, layout, err := parser.Parse("05/06/2079")
+	assert.Nil(t, err, "error should be nil")
+	assert.Equal(t, "2079-05-06 00:00:00", got.String())
+	assert.Equal(t, "%m/%d/%Y", layout)
+
+	got, layout, err = parser.Parse("20791014")
+	assert.Nil(t, err, "error should be nil")
+	assert.Equal(t, "2079-10-14 00:00:00", got.String())
+	assert.Equal(t, "%Y%m%d", layout)
+}
@@ -2,55 +2,172 @@ package nepalitime
 
 import (
 	"errors"
+	"fmt"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/opensource-nepal/go-nepali/constants"
+	"github.com/opensource-nepal/go-nepali/dateConverter"
+	"github.com/opensource-nepal/go-nepali/nepalinumber"
 )
 
-var nepaliTimeReCache *nepaliTimeRegex
+var (
+	nepaliTimeReCache *nepaliTimeRegex
+	nepaliTimeReOnce  sync.Once
+)
+
+// ParseMode decides how strictly a datetime string should match the format.
+type ParseMode int
+
+const (
+	// DefaultMode is the mode used by Parse().
+	// The match is case-insensitive, any whitespace matches a space, numbers may
+	// or may not be zero-padded and missing month and day are taken as 1.
+	DefaultMode ParseMode = iota
+
+	// StrictMode is meant for validating user input.
+	// The match is case-sensitive, literal text should match exactly, numbers
+	// should have the exact widths (eg. %d is 01 to 32, %-d is 1 to 32),
+	// leap seconds are rejected, month and day are required and
+	// the weekday (%A) should match the date.
+	StrictMode
+
+	// LenientMode is meant for bulk imports.
+	// Same as DefaultMode but the datetime string is trimmed and the separators
+	// (whitespace, '/', '-', '.', ',', ':' and '_') are optional and interchangeable,
+	// eg. "%Y/%m/%d" matches "2079-10-14", "2079 10 14" and "20791014".
+	LenientMode
+)
+
+// ParseOptions configures ParseWithOptions()
+type ParseOptions struct {
+	Mode ParseMode
+
+	// Location of the parsed time, Asia/Kathmandu if nil.
+	Location *time.Location
+
+	// YearPivot resolves the two-digit year of %y, 2000s if nil.
+	// See FixedYearPivot() and SlidingYearPivot().
+	YearPivot YearPivot
+}
 
 // Parse is equivalent to time.Parse()
+// The parsed time is in Asia/Kathmandu.
 func Parse(datetimeStr string, format string) (*NepaliTime, error) {
-	nepalitime, err := validate(datetimeStr, format)
+	return ParseWithOptions(datetimeStr, format, ParseOptions{})
+}
+
+// ParseInLocation is same as Parse() but the parsed time is in the given location.
+// Like time.Date(), it panics if loc is nil.
+func ParseInLocation(datetimeStr string, format string, loc *time.Location) (*NepaliTime, error) {
+	if loc == nil {
+		panic("nepalitime: missing Location in call to ParseInLocation")
+	}
 
+	return ParseWithOptions(datetimeStr, format, ParseOptions{Location: loc})
+}
+
+// ParseWithOptions is same as Parse() with the given options.
+//
+// The compiled format is cached, see SetLayoutCacheSize().
+func ParseWithOptions(datetimeStr string, format string, opts ParseOptions) (*NepaliTime, error) {
+	layout, err := getCachedLayout(format, opts.Mode)
 	if err != nil {
 		return nil, err
 	}
 
-	return nepalitime, nil
+	return validate(datetimeStr, layout, opts)
 }
 
 func getNepaliTimeReObject() *nepaliTimeRegex {
-	if nepaliTimeReCache == nil {
+	nepaliTimeReOnce.Do(func() {
 		nepaliTimeReCache = newNepaliTimeRegex()
-	}
+	})
 
 	return nepaliTimeReCache
 }
 
-// validates datetimeStr with the format
-func validate(datetimeStr string, format string) (*NepaliTime, error) {
+// validates datetimeStr with the layout
+func validate(datetimeStr string, layout *Layout, opts ParseOptions) (*NepaliTime, error) {
+	if opts.Mode == LenientMode {
+		datetimeStr = strings.TrimSpace(datetimeStr)
+	}
+	datetimeStr = nepalinumber.ToASCII(datetimeStr)
+
 	// validate if parse result is not empty
-	parsedResult, err := extract(datetimeStr, format)
+	parsedResult, err := extract(datetimeStr, layout)
 	if err != nil {
 		return nil, err
-	} else {
-		_, ok1 := parsedResult["Y"]
-		_, ok2 := parsedResult["y"]
+	}
+
+	_, hasMonthNumber := parsedResult["m"]
+	_, hasMonthName := parsedResult["B"]
+	_, hasDay := parsedResult["d"]
+	_, hasYearDay := parsedResult["j"]
+	hasMonth := hasMonthNumber || hasMonthName
+
+	// no default month and day in strict mode
+	if opts.Mode == StrictMode && !hasYearDay {
+		if !hasMonth {
+			return nil, errors.New("unable to parse month")
+		}
 
-		if !ok1 && !ok2 {
-			return nil, errors.New("unable to parse year")
+		if !hasDay {
+			return nil, errors.New("unable to parse day")
 		}
 	}
 
 	// validate the transformation
-	transformedData, err := transform(parsedResult)
+	transformedData, err := transform(parsedResult, opts.YearPivot)
 	if err != nil {
 		return nil, err
 	}
 
-	nepaliDate, err := Date(
+	// custom directives can set any field, eg. year from the fiscal year
+	fields := DirectiveFields{
+		Year:       transformedData["year"],
+		Month:      transformedData["month"],
+		Day:        transformedData["day"],
+		Hour:       transformedData["hour"],
+		Minute:     transformedData["minute"],
+		Second:     transformedData["second"],
+		Nanosecond: transformedData["nanosecond"],
+	}
+	if err := parseCustomDirectives(parsedResult, layout, &fields); err != nil {
+		return nil, err
+	}
+	transformedData["year"], transformedData["month"], transformedData["day"] = fields.Year, fields.Month, fields.Day
+	transformedData["hour"], transformedData["minute"], transformedData["second"] = fields.Hour, fields.Minute, fields.Second
+	transformedData["nanosecond"] = fields.Nanosecond
+
+	_, hasYear := parsedResult["Y"]
+	_, hasShortYear := parsedResult["y"]
+	if !hasYear && !hasShortYear && fields.Year == 0 {
+		return nil, errors.New("unable to parse year")
+	}
+
+	// building the date from the day of year (%Y + %j)
+	if hasYearDay && !hasMonth && !hasDay {
+		date, err := dateFromYearDay(transformedData["year"], transformedData["yearday"])
+		if err != nil {
+			return nil, err
+		}
+		transformedData["month"], transformedData["day"] = date.month, date.day
+	}
+
+	loc := opts.Location
+	if loc == nil {
+		loc = GetNepaliLocation()
+	}
+
+	// the parsed time is in the parsed UTC offset if present
+	if offset, ok := transformedData["offset"]; ok {
+		loc = time.FixedZone(offsetZoneName(offset), offset)
+	}
+
+	nepaliDate, err := DateIn(
 		transformedData["year"],
 		transformedData["month"],
 		transformedData["day"],
@@ -58,17 +175,71 @@ func validate(datetimeStr string, format string) (*NepaliTime, error) {
 		transformedData["minute"],
 		transformedData["second"],
 		transformedData["nanosecond"],
+		loc,
 	)
 	if err != nil {
 		return nil, err
 	}
 
+	if err := checkRedundantFields(parsedResult, nepaliDate); err != nil {
+		return nil, err
+	}
+
 	return nepaliDate, nil
 }
 
-// extracts year, month, day, hour, minute, etc from the given format
+// FieldConflictError is returned while parsing when a redundant field
+// (eg. weekday or day of year) doesn't agree with the parsed date.
+type FieldConflictError struct {
+	Directive string // directive of the redundant field, eg. "%A"
+	Value     string // value of the field in the datetime string
+	Expected  string // value according to the date
+}
+
+func (e *FieldConflictError) Error() string {
+	return fmt.Sprintf("%s is %q but it should be %q according to the date", e.Directive, e.Value, e.Expected)
+}
+
+// checks that the weekday and day of year fields agree with the parsed date
+func checkRedundantFields(data map[string]string, nepaliTime *NepaliTime) error {
+	weekday := nepaliTime.Weekday()
+
+	if val, ok := data["A"]; ok && !strings.EqualFold(val, weekday.String()) {
+		return &FieldConflictError{"%A", val, weekday.String()}
+	}
+	if val, ok := data["a"]; ok && !strings.EqualFold(val, weekday.String()[:3]) {
+		return &FieldConflictError{"%a", val, weekday.String()[:3]}
+	}
+	if val, ok := data["w"]; ok && val != strconv.Itoa(int(weekday)) {
+		return &FieldConflictError{"%w", val, strconv.Itoa(int(weekday))}
+	}
+	if val, ok := data["j"]; ok {
+		// the value is validated by transform
+		yearDay, _ := strconv.Atoi(val)
+		if yearDay != nepaliTime.YearDay() {
+			return &FieldConflictError{"%j", val, strconv.Itoa(nepaliTime.YearDay())}
+		}
+	}
+
+	return nil
+}
+
+// returns the date of the day of the year (1 = Baisakh 1)
+func dateFromYearDay(year int, yearDay int) (NepaliDate, error) {
+	yearDays, err := dateConverter.NepaliYearDays(year)
+	if err != nil {
+		return NepaliDate{}, err
+	}
+	if yearDay < 1 || yearDay > yearDays {
+		return NepaliDate{}, errors.New("invalid value in %j")
+	}
+
+	return NepaliDate{year, 1, 1}.AddDays(yearDay - 1)
+}
+
+// extracts year, month, day, hour, minute, etc from the given layout
 // eg.
-// USAGE: extract("2078-01-12", "%Y-%m-%d")
+// USAGE: extract("2078-01-12", MustCompileLayout("%Y-%m-%d"))
 // INPUT:
 // datetime_str="2078-01-12"
 // format="%Y-%m-%d"
@@ -79,12 +250,8 @@ func validate(datetimeStr string, format string) (*NepaliTime, error) {
 //		"m": 1,
 //		"d": 12,
 //	}
-func extract(datetimeStr string, format string) (map[string]string, error) {
-	reCompiledFormat, err := getNepaliTimeReObject().compile(format)
-
-	if err != nil {
-		return nil, err
-	}
+func extract(datetimeStr string, layout *Layout) (map[string]string, error) {
+	reCompiledFormat := layout.re
 
 	match := reCompiledFormat.FindStringSubmatch(datetimeStr)
 
@@ -122,11 +289,12 @@ func extract(datetimeStr string, format string) (map[string]string, error) {
 //	    "day": 12,
 //	    ...
 //	}
-func transform(data map[string]string) (map[string]int, error) {
+func transform(data map[string]string, yearPivot YearPivot) (map[string]int, error) {
 	var (
 		year                           int
 		month, day                     int = 1, 1
 		hour, minute, second, fraction int = 0, 0, 0, 0
+		offset, yearDay                *int
 	)
 
 	for key, val := range data {
@@ -136,8 +304,10 @@ func transform(data map[string]string) (map[string]int, error) {
 				return nil, errors.New("invalid value in %y")
 			}
 
-			year = intVal
-			year += 2000
+			// %Y is preferred when both are present
+			if _, ok := data["Y"]; !ok {
+				year = yearPivot.resolve(intVal)
+			}
 		} else if key == "Y" {
 			intVal, err := strconv.Atoi(val)
 			if err != nil {
@@ -152,6 +322,13 @@ func transform(data map[string]string) (map[string]int, error) {
 			}
 
 			month = intVal
+		} else if key == "j" {
+			intVal, err := strconv.Atoi(val)
+			if err != nil {
+				return nil, errors.New("invalid value in %j")
+			}
+
+			yearDay = &intVal
 		} else if key == "d" {
 			intVal, err := strconv.Atoi(val)
 			if err != nil {
@@ -160,18 +337,15 @@ func transform(data map[string]string) (map[string]int, error) {
 
 			day = intVal
 		} else if key == "B" {
-			intVal := 0
-			for i, m := range constants.NepaliMonths {
-				if m == val {
-					intVal = i + 1
-					break
-				}
-			}
+			intVal := monthFromName(val)
 			if intVal == 0 {
 				return nil, errors.New("invalid value in %B")
 			}
 
-			month = intVal
+			// %m is preferred when both are present
+			if _, ok := data["m"]; !ok {
+				month = intVal
+			}
 		} else if key == "H" {
 			intVal, err := strconv.Atoi(val)
 			if err != nil {
@@ -228,10 +402,29 @@ func transform(data map[string]string) (map[string]int, error) {
 			if err != nil {
 				return nil, errors.New("invalid value in %f")
 			}
+		} else if key == "z" {
+			seconds, err := parseUTCOffset(val)
+			if err != nil {
+				return nil, err
+			}
+
+			offset = &seconds
 		}
 	}
 
-	return map[string]int{
+	// redundant fields should agree
+	if shortYear, ok := data["y"]; ok {
+		if fullYear, ok := data["Y"]; ok && len(fullYear) >= 2 && fullYear[len(fullYear)-2:] != shortYear {
+			return nil, &FieldConflictError{"%y", shortYear, fullYear[len(fullYear)-2:]}
+		}
+	}
+	if monthName, ok := data["B"]; ok && data["m"] != "" {
+		if monthFromName(monthName) != month {
+			return nil, &FieldConflictError{"%B", monthName, constants.NepaliMonths[month-1]}
+		}
+	}
+
+	result := map[string]int{
 		"year":       year,
 		"month":      month,
 		"day":        day,
@@ -239,5 +432,63 @@ func transform(data map[string]string) (map[string]int, error) {
 		"minute":     minute,
 		"second":     second,
 		"nanosecond": fraction,
-	}, nil
+	}
+	if offset != nil {
+		result["offset"] = *offset
+	}
+	if yearDay != nil {
+		result["yearday"] = *yearDay
+	}
+
+	return result, nil
+}
+
+// name of the zone of a parsed UTC offset, so %Z and the location's name aren't empty
+// eg. "+0545", "-0230", "+053045" and "UTC" for the zero offset
+func offsetZoneName(offset int) string {
+	if offset == 0 {
+		return "UTC"
+	}
+
+	sign := byte('+')
+	if offset < 0 {
+		sign, offset = '-', -offset
+	}
+	name := fmt.Sprintf("%c%02d%02d", sign, offset/3600, offset/60%60)
+	if seconds := offset % 60; seconds != 0 {
+		name += fmt.Sprintf("%02d", seconds)
+	}
+	return name
+}
+
+// parses the UTC offset of %z into seconds
+// eg. "+0545", "+05:45", "-0230", "Z"
+func parseUTCOffset(val string) (int, error) {
+	if strings.EqualFold(val, "Z") {
+		return 0, nil
+	}
+
+	sign := 1
+	if val[0] == '-' {
+		sign = -1
+	}
+
+	// removing the sign, colons and the fraction of second
+	digits := strings.ReplaceAll(val[1:], ":", "")
+	if index := strings.Index(digits, "."); index != -1 {
+		digits = digits[:index]
+	}
+
+	hours, err1 := strconv.Atoi(digits[0:2])
+	minutes, err2 := strconv.Atoi(digits[2:4])
+	seconds := 0
+	var err3 error
+	if len(digits) >= 6 {
+		seconds, err3 = strconv.Atoi(digits[4:6])
+	}
+	if err1 != nil || err2 != nil || err3 != nil {
+		return 0, errors.New("invalid value in %z")
+	}
+
+	return sign * (hours*60*60 + minutes*60 + seconds), nil
 }
@@ -3,6 +3,7 @@ package nepalitime_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/opensource-nepal/go-nepali/nepalitime"
 	"github.com/stretchr/testify/assert"
@@ -332,3 +333,228 @@ func TestParseFor12AM(t *testing.T) {
 	assert.Nil(t, err, "error should be nil")
 	assert.Equal(t, got.String(), "2079-10-14 00:00:00")
 }
+
+func TestParseInLocation(t *testing.T) {
+	got, err := nepalitime.ParseInLocation("2079/10/14 21:27:30", "%Y/%m/%d %H:%M:%S", time.UTC)
+
+	assert.Nil(t, err, "error should be nil")
+	assert.Equal(t, "2079-10-14 21:27:30", got.String())
+	assert.Equal(t, time.UTC, got.GetEnglishTime().Location())
+}
+
+func TestParseIsInNepaliLocation(t *testing.T) {
+	got, err := nepalitime.Parse("2079/10/14", "%Y/%m/%d")
+
+	assert.Nil(t, err, "error should be nil")
+	assert.Equal(t, nepalitime.GetNepaliLocation(), got.GetEnglishTime().Location())
+}
+
+func TestParseWithUTCOffset(t *testing.T) {
+	got, err := nepalitime.Parse("2079/10/14 21:27:30 +0000", "%Y/%m/%d %H:%M:%S %z")
+
+	assert.Nil(t, err, "error should be nil")
+	assert.Equal(t, "2079-10-14 21:27:30", got.String())
+	assert.Equal(t, time.Date(2023, 1, 28, 21, 27, 30, 0, time.UTC), got.GetEnglishTime().UTC())
+}
+
+func TestParseWithHistoricalUTCOffset(t *testing.T) {
+	got, err := nepalitime.Parse("2042/09/16 23:50 +05:30", "%Y/%m/%d %H:%M %z")
+
+	assert.Nil(t, err, "error should be nil")
+	assert.Equal(t, time.Date(1985, 12, 31, 18, 20, 0, 0, time.UTC), got.GetEnglishTime().UTC())
+	assert.Equal(t, "+0530", got.Format("%z"))
+}
+
+func TestParseWithUTCOffsetNamesZone(t *testing.T) {
+	testCases := []struct {
+		datetime string
+		zone     string
+	}{
+		{"2079/10/14 10:00 +0000", "UTC"},
+		{"2079/10/14 10:00 Z", "UTC"},
+		{"2079/10/14 10:00 +05:30", "+0530"},
+		{"2079/10/14 10:00 -0230", "-0230"},
+		{"2079/10/14 10:00 +05:45:30", "+054530"},
+	}
+
+	for _, tc := range testCases {
+		got, err := nepalitime.Parse(tc.datetime, "%Y/%m/%d %H:%M %z")
+
+		assert.Nil(t, err, tc.datetime)
+		assert.Equal(t, tc.zone, got.GetEnglishTime().Location().String(), tc.datetime)
+		assert.Equal(t, tc.zone, got.Format("%Z"), tc.datetime)
+	}
+}
+
+// Parse modes
+
+func TestParseDefaultModeDefaultsMonthAndDay(t *testing.T) {
+	got, err := nepalitime.Parse("2079", "%Y")
+
+	assert.Nil(t, err, "error should be nil")
+	assert.Equal(t, "2079-01-01 00:00:00", got.String())
+}
+
+func TestParseWithWeekday(t *testing.T) {
+	got, err := nepalitime.Parse("Saturday, 2079/10/14", "%A, %Y/%m/%d")
+
+	assert.Nil(t, err, "error should be nil")
+	assert.Equal(t, "2079-10-14 00:00:00", got.String())
+}
+
+func TestParseStrictMode(t *testing.T) {
+	opts := nepalitime.ParseOptions{Mode: nepalitime.StrictMode}
+
+	got, err := nepalitime.ParseWithOptions("2079/10/14 09:05:59", "%Y/%m/%d %H:%M:%S", opts)
+	assert.Nil(t, err, "error should be nil")
+	assert.Equal(t, "2079-10-14 09:05:59", got.String())
+
+	got, err = nepalitime.ParseWithOptions("2079/1/4", "%Y/%-m/%-d", opts)
+	assert.Nil(t, err, "error should be nil")
+	assert.Equal(t, "2079-01-04 00:00:00", got.String())
+}
+
+func TestParseStrictModeRejectsInvalidInputs(t *testing.T) {
+	opts := nepalitime.ParseOptions{Mode: nepalitime.StrictMode}
+
+	testCases := []struct {
+		datetimeStr string
+		format      string
+	}{
+		{"2079/1/4", "%Y/%m/%d"},                            // exact widths
+		{"2079/01/04", "%Y/%-m/%-d"},                        // no zero-padding
+		{"2079/10/14 10:10:60", "%Y/%m/%d %H:%M:%S"},        // leap second
+		{"2079/10/14  10:10", "%Y/%m/%d %H:%M"},             // whitespace
+		{"2079/10", "%Y/%m"},                                // missing day
+		{"2079", "%Y"},                                      // missing month
+		{"14 magh 2079", "%d %B %Y"},                        // case
+		{"Sunday 2079/10/14", "%A %Y/%m/%d"},                // wrong weekday
+		{"2079/10/14 10:10:10.123", "%Y/%m/%d %H:%M:%S.%f"}, // exact fraction
+	}
+
+	for _, tc := range testCases {
+		got, err := nepalitime.ParseWithOptions(tc.datetimeStr, tc.format, opts)
+		assert.Nil(t, got, tc.datetimeStr)
+		assert.NotNil(t, err, tc.datetimeStr)
+	}
+}
+
+func TestParseDefaultModeAcceptsLeapSecondAndWhitespaceRun(t *testing.T) {
+	_, err := nepalitime.Parse("2079/10/14  10:10", "%Y/%m/%d %H:%M")
+	assert.Nil(t, err, "error should be nil")
+}
+
+func TestParseLenientMode(t *testing.T) {
+	opts := nepalitime.ParseOptions{Mode: nepalitime.LenientMode}
+
+	for _, datetimeStr := range []string{"2079/10/14", " 2079-10-14\n", "2079.10.14", "2079 10 14", "20791014"} {
+		got, err := nepalitime.ParseWithOptions(datetimeStr, "%Y/%m/%d", opts)
+		assert.Nil(t, err, datetimeStr)
+		assert.Equal(t, "2079-10-14 00:00:00", got.String(), datetimeStr)
+	}
+
+	got, err := nepalitime.ParseWithOptions("14 MAGH, 2079", "%d %B %Y", opts)
+	assert.Nil(t, err, "error should be nil")
+	assert.Equal(t, "2079-10-14 00:00:00", got.String())
+}
+
+func TestParseWithOptionsLocation(t *testing.T) {
+	got, err := nepalitime.ParseWithOptions("2079/10/14", "%Y/%m/%d", nepalitime.ParseOptions{Location: time.UTC})
+
+	assert.Nil(t, err, "error should be nil")
+	assert.Equal(t, time.UTC, got.GetEnglishTime().Location())
+}
+
+func TestParseWithTrailingPercent(t *testing.T) {
+	got, err := nepalitime.Parse("2079%", "%Y%")
+
+	assert.Nil(t, got)
+	assert.NotNil(t, err)
+}
+
+func TestCompileLayoutWithOptions(t *testing.T) {
+	layout, err := nepalitime.CompileLayoutWithOptions("%Y/%m/%d", nepalitime.ParseOptions{Mode: nepalitime.StrictMode})
+	assert.Nil(t, err, "error should be nil")
+
+	_, err = layout.Parse("2079/1/4")
+	assert.NotNil(t, err)
+
+	got, err := layout.ParseInLocation("2079/01/04", time.UTC)
+	assert.Nil(t, err, "error should be nil")
+	assert.Equal(t, time.UTC, got.GetEnglishTime().Location())
+}
+
+func TestParseWithMonthNameChaitra(t *testing.T) {
+	got, err := nepalitime.Parse("30 Chaitra 2079", "%d %B %Y")
+
+	assert.Nil(t, err, "error should be nil")
+	assert.Equal(t, "2079-12-30 00:00:00", got.String())
+}
+
+// Redundant fields
+
+func TestParseWithConflictingRedundantFields(t *testing.T) {
+	testCases := []struct {
+		datetimeStr string
+		format      string
+		directive   string
+	}{
+		{"Sunday 2079/10/14", "%A %Y/%m/%d", "%A"},
+		{"Sun 2079/10/14", "%a %Y/%m/%d", "%a"},
+		{"0 2079/10/14", "%w %Y/%m/%d", "%w"},
+		{"2079/10/14 100", "%Y/%m/%d %j", "%j"},
+		{"2079/80/10/14", "%Y/%y/%m/%d", "%y"},
+		{"2079/Poush/10/14", "%Y/%B/%m/%d", "%B"},
+	}
+
+	for _, tc := range testCases {
+		got, err := nepalitime.Parse(tc.datetimeStr, tc.format)
+		assert.Nil(t, got, tc.datetimeStr)
+
+		var conflictErr *nepalitime.FieldConflictError
+		if assert.ErrorAs(t, err, &conflictErr, tc.datetimeStr) {
+			assert.Equal(t, tc.directive, conflictErr.Directive)
+		}
+	}
+}
+
+func TestParseWithConflictingWeekdayErrorMessage(t *testing.T) {
+	_, err := nepalitime.Parse("Sunday 2079/10/14", "%A %Y/%m/%d")
+
+	assert.EqualError(t, err, `%A is "Sunday" but it should be "Saturday" according to the date`)
+}
+
+func TestParseWithAgreeingRedundantFields(t *testing.T) {
+	got, err := nepalitime.Parse("Sat, 6 2079/79/Magh/10/14 290", "%a, %w %Y/%y/%B/%m/%d %j")
+
+	assert.Nil(t, err, "error should be nil")
+	assert.Equal(t, "2079-10-14 00:00:00", got.String())
+}
+
+func TestParseWithYearAndDayOfYear(t *testing.T) {
+	got, err := nepalitime.Parse("2079-290", "%Y-%j")
+	assert.Nil(t, err, "error should be nil")
+	assert.Equal(t, "2079-10-14 00:00:00", got.String())
+
+	got, err = nepalitime.ParseWithOptions("2079-001", "%Y-%j", nepalitime.ParseOptions{Mode: nepalitime.StrictMode})
+	assert.Nil(t, err, "error should be nil")
+	assert.Equal(t, "2079-01-01 00:00:00", got.String())
+}
+
+func TestParseWithDayOfYearOutOfYear(t *testing.T) {
+	// 2079 has 365 days
+	got, err := nepalitime.Parse("2079-366", "%Y-%j")
+
+	assert.Nil(t, got)
+	assert.NotNil(t, err)
+}
+
+func TestParseWithDevanagariDigitsAndMonthName(t *testing.T) {
+	got, err := nepalitime.Parse("१४ माघ २०७९", "%d %B %Y")
+	assert.Nil(t, err, "error should be nil")
+	assert.Equal(t, "2079-10-14 00:00:00", got.String())
+
+	got, err = nepalitime.Parse("३० चैत्र २०७९", "%d %B %Y")
+	assert.Nil(t, err, "error should be nil")
+	assert.Equal(t, "2079-12-30 00:00:00", got.String())
+}
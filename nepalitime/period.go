@@ -0,0 +1,201 @@
+package nepalitime
+
+import (
+	"fmt"
+
+	"github.com/opensource-nepal/go-nepali/dateConverter"
+)
+
+// Unit is a nepali calendar unit used by StartOf(), EndOf(), Truncate() and Round().
+type Unit int
+
+const (
+	Day  Unit = iota
+	Week      // starts on Sunday
+	Month
+	// Trimester is the four months period of the fiscal year:
+	// Shrawan to Kartik, Mangsir to Falgun and Chaitra to Asar.
+	Trimester
+	Year
+	// FiscalYear starts on Shrawan 1, eg. FY 2080/81 is from 2080-04-01 to 2081-03-32.
+	FiscalYear
+)
+
+func (u Unit) String() string {
+	switch u {
+	case Day:
+		return "Day"
+	case Week:
+		return "Week"
+	case Month:
+		return "Month"
+	case Trimester:
+		return "Trimester"
+	case Year:
+		return "Year"
+	case FiscalYear:
+		return "FiscalYear"
+	default:
+		return fmt.Sprintf("Unit(%d)", int(u))
+	}
+}
+
+// first month of the fiscal year, Shrawan
+const fiscalYearStartMonth = 4
+
+// StartOf returns the first day of the period of the unit containing the date.
+// Returns error if the start is out of the supported range,
+// eg. the fiscal year of 2000-01-01 starts in 1999.
+func (d NepaliDate) StartOf(unit Unit) (NepaliDate, error) {
+	switch unit {
+	case Day:
+		return d, nil
+	case Week:
+		return d.AddDays(-int(d.Weekday()))
+	case Month, Trimester, Year, FiscalYear:
+		year, month, _ := d.periodMonths(unit)
+		return NewDate(year, month, 1)
+	default:
+		return NepaliDate{}, fmt.Errorf("invalid unit %s", unit)
+	}
+}
+
+// EndOf returns the last day of the period of the unit containing the date,
+// eg. the 29th, 30th, 31st or 32nd day for Month.
+// Returns error if the end is out of the supported range.
+func (d NepaliDate) EndOf(unit Unit) (NepaliDate, error) {
+	switch unit {
+	case Day:
+		return d, nil
+	case Week:
+		return d.AddDays(6 - int(d.Weekday()))
+	case Month, Trimester, Year, FiscalYear:
+		year, month, months := d.periodMonths(unit)
+		year, month = addMonths(year, month, months-1)
+
+		days, err := dateConverter.NepaliMonthDays(year, month)
+		if err != nil {
+			return NepaliDate{}, err
+		}
+		return NepaliDate{year, month, days}, nil
+	default:
+		return NepaliDate{}, fmt.Errorf("invalid unit %s", unit)
+	}
+}
+
+// first month and the number of months of the period of the unit containing the date
+func (d NepaliDate) periodMonths(unit Unit) (year, month, months int) {
+	switch unit {
+	case Month:
+		return d.year, d.month, 1
+	case Year:
+		return d.year, 1, 12
+	case Trimester:
+		months = 4
+	default:
+		months = 12
+	}
+
+	// months since the start of the fiscal year
+	elapsed := (d.month - fiscalYearStartMonth + 12) % 12
+	year, month = addMonths(d.year, d.month, -(elapsed % months))
+	return year, month, months
+}
+
+// Truncate returns the start of the period of the unit containing the nepali time,
+// at midnight in the same location. eg. Truncate(Month) of 2080-04-15 10:30 is 2080-04-01 00:00.
+//
+// Returns error if the start is out of the supported range.
+func (obj *NepaliTime) Truncate(unit Unit) (*NepaliTime, error) {
+	start, err := obj.NepaliDate().StartOf(unit)
+	if err != nil {
+		return nil, err
+	}
+
+	return obj.atMidnight(start)
+}
+
+// Round returns the start of the period of the unit nearest to the nepali time,
+// the halfway values are rounded up. eg. Round(Month) of 2080-04-20 is 2080-05-01.
+// The periods are measured in real durations, so a 32 days month is longer than a 29 days month.
+//
+// Returns error if the result is out of the supported range.
+func (obj *NepaliTime) Round(unit Unit) (*NepaliTime, error) {
+	start, err := obj.Truncate(unit)
+	if err != nil {
+		return nil, err
+	}
+
+	end, err := obj.NepaliDate().EndOf(unit)
+	if err != nil {
+		return nil, err
+	}
+	nextDate, err := end.AddDays(1)
+	if err != nil {
+		// the next period is out of range
+		return nil, err
+	}
+	next, err := obj.atMidnight(nextDate)
+	if err != nil {
+		return nil, err
+	}
+
+	if obj.englishTime.Sub(*start.englishTime) < next.englishTime.Sub(*obj.englishTime) {
+		return start, nil
+	}
+	return next, nil
+}
+
+// EndOf returns the last nanosecond of the period of the unit containing the nepali time,
+// eg. EndOf(Month) of 2080-04-15 is 2080-04-32 23:59:59.999999999.
+//
+// Returns error if the end is out of the supported range.
+func (obj *NepaliTime) EndOf(unit Unit) (*NepaliTime, error) {
+	end, err := obj.NepaliDate().EndOf(unit)
+	if err != nil {
+		return nil, err
+	}
+
+	return DateIn(end.year, end.month, end.day, 23, 59, 59, 999999999, obj.englishTime.Location())
+}
+
+// StartOfDay returns the nepali time at midnight of the same day.
+func (obj *NepaliTime) StartOfDay() *NepaliTime {
+	start, _ := obj.Truncate(Day)
+	return start
+}
+
+// StartOfMonth returns the nepali time at midnight of the first day of the month.
+func (obj *NepaliTime) StartOfMonth() *NepaliTime {
+	start, _ := obj.Truncate(Month)
+	return start
+}
+
+// EndOfMonth returns the last nanosecond of the last day (29 to 32) of the month.
+func (obj *NepaliTime) EndOfMonth() *NepaliTime {
+	end, _ := obj.EndOf(Month)
+	return end
+}
+
+// StartOfYear returns the nepali time at midnight of Baisakh 1.
+func (obj *NepaliTime) StartOfYear() *NepaliTime {
+	start, _ := obj.Truncate(Year)
+	return start
+}
+
+// EndOfYear returns the last nanosecond of the last day of Chaitra.
+func (obj *NepaliTime) EndOfYear() *NepaliTime {
+	end, _ := obj.EndOf(Year)
+	return end
+}
+
+// nepali time at midnight of the date in the same location
+func (obj *NepaliTime) atMidnight(date NepaliDate) (*NepaliTime, error) {
+	return DateIn(date.year, date.month, date.day, 0, 0, 0, 0, obj.englishTime.Location())
+}
+
+// adds n months to the year and month, n can be negative
+func addMonths(year, month, n int) (int, int) {
+	months := year*12 + month - 1 + n
+	return months / 12, months%12 + 1
+}
@@ -0,0 +1,152 @@
+package nepalitime_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/opensource-nepal/go-nepali/nepalitime"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStartAndEndOfPeriods(t *testing.T) {
+	nt, _ := nepalitime.Date(2080, 4, 15, 10, 30, 0, 0)
+
+	assert.Equal(t, "2080-04-15 00:00:00", nt.StartOfDay().String())
+	assert.Equal(t, "2080-04-01 00:00:00", nt.StartOfMonth().String())
+	assert.Equal(t, "2080-01-01 00:00:00", nt.StartOfYear().String())
+
+	// Shrawan 2080 has 32 days
+	assert.Equal(t, "2080-04-32 23:59:59", nt.EndOfMonth().String())
+	assert.Equal(t, 999999999, nt.EndOfMonth().Nanosecond())
+	assert.Equal(t, "2080-12-30 23:59:59", nt.EndOfYear().String())
+}
+
+func TestEndOfMonthWithMonthLengths(t *testing.T) {
+	testCases := []struct {
+		year, month int
+		expected    string
+	}{
+		{2080, 2, "2080-02-32 23:59:59"},
+		{2080, 3, "2080-03-31 23:59:59"},
+		{2080, 6, "2080-06-30 23:59:59"},
+		{2080, 9, "2080-09-29 23:59:59"},
+	}
+
+	for _, tc := range testCases {
+		nt, _ := nepalitime.Date(tc.year, tc.month, 10, 0, 0, 0, 0)
+		assert.Equal(t, tc.expected, nt.EndOfMonth().String())
+	}
+}
+
+func TestNepaliDateStartOfAndEndOf(t *testing.T) {
+	testCases := []struct {
+		date       nepalitime.NepaliDate
+		unit       nepalitime.Unit
+		start, end string
+	}{
+		{mustDate(t, 2080, 4, 15), nepalitime.Day, "2080-04-15", "2080-04-15"},
+		{mustDate(t, 2080, 4, 15), nepalitime.Week, "2080-04-14", "2080-04-20"}, // Monday
+		{mustDate(t, 2080, 4, 15), nepalitime.Month, "2080-04-01", "2080-04-32"},
+		{mustDate(t, 2080, 4, 15), nepalitime.Trimester, "2080-04-01", "2080-07-30"},
+		{mustDate(t, 2080, 10, 5), nepalitime.Trimester, "2080-08-01", "2080-11-30"},
+		{mustDate(t, 2080, 12, 5), nepalitime.Trimester, "2080-12-01", "2081-03-31"},
+		{mustDate(t, 2081, 2, 10), nepalitime.Trimester, "2080-12-01", "2081-03-31"},
+		{mustDate(t, 2080, 4, 15), nepalitime.Year, "2080-01-01", "2080-12-30"},
+		{mustDate(t, 2080, 4, 15), nepalitime.FiscalYear, "2080-04-01", "2081-03-31"},
+		{mustDate(t, 2081, 3, 31), nepalitime.FiscalYear, "2080-04-01", "2081-03-31"},
+	}
+
+	for _, tc := range testCases {
+		start, err := tc.date.StartOf(tc.unit)
+		if assert.Nil(t, err, "%s %s", tc.date, tc.unit) {
+			assert.Equal(t, tc.start, start.String(), "%s %s", tc.date, tc.unit)
+		}
+
+		end, err := tc.date.EndOf(tc.unit)
+		if assert.Nil(t, err, "%s %s", tc.date, tc.unit) {
+			assert.Equal(t, tc.end, end.String(), "%s %s", tc.date, tc.unit)
+		}
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	nt, _ := nepalitime.Date(2081, 2, 10, 10, 30, 0, 0)
+
+	got, err := nt.Truncate(nepalitime.Trimester)
+	assert.Nil(t, err, "error should be nil")
+	assert.Equal(t, "2080-12-01 00:00:00", got.String())
+
+	got, err = nt.Truncate(nepalitime.FiscalYear)
+	assert.Nil(t, err, "error should be nil")
+	assert.Equal(t, "2080-04-01 00:00:00", got.String())
+}
+
+func TestTruncateKeepsLocation(t *testing.T) {
+	nt, _ := nepalitime.DateIn(2080, 4, 15, 10, 30, 0, 0, time.UTC)
+
+	got, err := nt.Truncate(nepalitime.Month)
+	assert.Nil(t, err, "error should be nil")
+	assert.Equal(t, time.UTC, got.GetEnglishTime().Location())
+	assert.Equal(t, "2080-04-01 00:00:00", got.String())
+}
+
+func TestRound(t *testing.T) {
+	testCases := []struct {
+		nt       *nepalitime.NepaliTime
+		unit     nepalitime.Unit
+		expected string
+	}{
+		{mustTime(t, 2080, 4, 15, 11), nepalitime.Day, "2080-04-15 00:00:00"},
+		{mustTime(t, 2080, 4, 15, 12), nepalitime.Day, "2080-04-16 00:00:00"},
+		// Shrawan 2080 has 32 days
+		{mustTime(t, 2080, 4, 16, 12), nepalitime.Month, "2080-04-01 00:00:00"},
+		{mustTime(t, 2080, 4, 17, 0), nepalitime.Month, "2080-05-01 00:00:00"},
+		// Poush 2080 has 29 days
+		{mustTime(t, 2080, 9, 15, 11), nepalitime.Month, "2080-09-01 00:00:00"},
+		{mustTime(t, 2080, 9, 15, 12), nepalitime.Month, "2080-10-01 00:00:00"},
+		{mustTime(t, 2080, 7, 1, 0), nepalitime.Year, "2081-01-01 00:00:00"},
+		{mustTime(t, 2080, 10, 1, 0), nepalitime.FiscalYear, "2080-04-01 00:00:00"},
+		{mustTime(t, 2080, 10, 5, 0), nepalitime.FiscalYear, "2081-04-01 00:00:00"},
+	}
+
+	for _, tc := range testCases {
+		got, err := tc.nt.Round(tc.unit)
+		if assert.Nil(t, err, "%s %s", tc.nt, tc.unit) {
+			assert.Equal(t, tc.expected, got.String(), "%s %s", tc.nt, tc.unit)
+		}
+	}
+}
+
+func TestPeriodOutOfRange(t *testing.T) {
+	_, err := mustTime(t, 2000, 1, 5, 0).Truncate(nepalitime.FiscalYear)
+	assert.NotNil(t, err, "error should not be nil")
+
+	_, err = mustTime(t, 2099, 10, 1, 0).Round(nepalitime.Year)
+	assert.NotNil(t, err, "error should not be nil")
+
+	_, err = mustDate(t, 2099, 5, 1).EndOf(nepalitime.FiscalYear)
+	assert.NotNil(t, err, "error should not be nil")
+
+	// the start is out of range but not the end
+	end, err := mustDate(t, 2000, 1, 5).EndOf(nepalitime.FiscalYear)
+	assert.Nil(t, err, "error should be nil")
+	assert.Equal(t, 3, end.Month())
+}
+
+func TestPeriodWithInvalidUnit(t *testing.T) {
+	_, err := mustDate(t, 2080, 4, 15).StartOf(nepalitime.Unit(99))
+	assert.EqualError(t, err, "invalid unit Unit(99)")
+
+	_, err = mustTime(t, 2080, 4, 15, 0).Round(nepalitime.Unit(99))
+	assert.NotNil(t, err, "error should not be nil")
+}
+
+func mustTime(t *testing.T, year, month, day, hour int) *nepalitime.NepaliTime {
+	t.Helper()
+
+	nt, err := nepalitime.Date(year, month, day, hour, 0, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return nt
+}
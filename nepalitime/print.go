@@ -0,0 +1,132 @@
+package nepalitime
+
+import (
+	"fmt"
+	"log/slog"
+	"strconv"
+	"time"
+)
+
+// NOTE:
+// NepaliTime can't implement fmt.Formatter since it already has Format(format string),
+// so %+v of NepaliTime prints only String() and the AD time and the zone are printed through Printable().
+
+// GoString implements fmt.GoStringer and formats the nepali time for %#v,
+// eg. nepalitime.Date(2079, 10, 6, 1, 0, 5, 0)
+func (obj *NepaliTime) GoString() string {
+	if obj == nil {
+		return "(*nepalitime.NepaliTime)(nil)"
+	}
+
+	h, m, s := obj.Clock()
+	loc := obj.englishTime.Location()
+	if loc == GetNepaliLocation() {
+		return fmt.Sprintf("nepalitime.Date(%d, %d, %d, %d, %d, %d, %d)", obj.year, obj.month, obj.day, h, m, s, obj.Nanosecond())
+	}
+
+	return fmt.Sprintf("nepalitime.DateIn(%d, %d, %d, %d, %d, %d, %d, %s)", obj.year, obj.month, obj.day, h, m, s, obj.Nanosecond(), locationGoString(loc))
+}
+
+// LogValue implements slog.LogValuer.
+// The nepali time is logged as a group of the BS time, AD time (RFC 3339) and the unix time,
+// eg. time.bs=2079-10-06 01:00:05 time.ad=2023-01-20T01:00:05+05:45 time.unix=1674155705
+func (obj *NepaliTime) LogValue() slog.Value {
+	if obj == nil {
+		return slog.StringValue("<nil>")
+	}
+
+	return slog.GroupValue(
+		slog.String("bs", obj.String()),
+		slog.String("ad", obj.englishTime.Format(time.RFC3339Nano)),
+		slog.Int64("unix", obj.englishTime.Unix()),
+	)
+}
+
+// Printable returns the nepali time wrapped in a fmt.Formatter, eg.
+//
+//	fmt.Printf("%v", npTime.Printable())  // 2079-10-06 01:00:05
+//	fmt.Printf("%+v", npTime.Printable()) // 2079-10-06 01:00:05 BS (2023-01-20 01:00:05 AD) +0545 Asia/Kathmandu
+//	fmt.Printf("%#v", npTime.Printable()) // nepalitime.Date(2079, 10, 6, 1, 0, 5, 0)
+func (obj *NepaliTime) Printable() Printable {
+	return Printable{obj}
+}
+
+// Printable implements fmt.Formatter for NepaliTime, see NepaliTime.Printable().
+type Printable struct {
+	nepaliTime *NepaliTime
+}
+
+// Format implements fmt.Formatter.
+func (p Printable) Format(f fmt.State, verb rune) {
+	if p.nepaliTime == nil {
+		formatValue(f, verb, "<nil>", "(*nepalitime.NepaliTime)(nil)")
+		return
+	}
+
+	str := p.nepaliTime.String()
+	if verb == 'v' && f.Flag('+') {
+		englishTime := p.nepaliTime.englishTime
+		str = fmt.Sprintf(
+			"%s BS (%s AD) %s %s",
+			str,
+			englishTime.Format(time.DateTime),
+			englishTime.Format("-0700"),
+			englishTime.Location(),
+		)
+	}
+	formatValue(f, verb, str, p.nepaliTime.GoString())
+}
+
+// GoString implements fmt.GoStringer and formats the date for %#v,
+// eg. nepalitime.NewDate(2079, 10, 14)
+func (d NepaliDate) GoString() string {
+	return fmt.Sprintf("nepalitime.NewDate(%d, %d, %d)", d.year, d.month, d.day)
+}
+
+// Format implements fmt.Formatter.
+// %v and %s print "2079-10-14", %+v prints the AD equivalent too, eg. "2079-10-14 BS (2023-01-28 AD)"
+// and %#v prints GoString().
+func (d NepaliDate) Format(f fmt.State, verb rune) {
+	str := d.String()
+	if verb == 'v' && f.Flag('+') {
+		str = fmt.Sprintf("%s BS (%s AD)", str, d.englishDate().Format(time.DateOnly))
+	}
+	formatValue(f, verb, str, d.GoString())
+}
+
+// LogValue implements slog.LogValuer.
+// The date is logged as a group of the BS and AD dates, eg. date.bs=2079-10-14 date.ad=2023-01-28
+func (d NepaliDate) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("bs", d.String()),
+		slog.String("ad", d.englishDate().Format(time.DateOnly)),
+	)
+}
+
+// writes the string for %v, %s and %q with the width and flags of the state,
+// goString for %#v and a bad verb error for other verbs
+func formatValue(f fmt.State, verb rune, str string, goString string) {
+	switch {
+	case verb == 'v' && f.Flag('#'):
+		fmt.Fprint(f, goString)
+	case verb == 'v' || verb == 's' || verb == 'q':
+		if verb == 'v' {
+			verb = 's'
+		}
+		fmt.Fprintf(f, fmt.FormatString(f, verb), str)
+	default:
+		fmt.Fprintf(f, "%%!%c(%s)", verb, str)
+	}
+}
+
+// go syntax of the location, same as time.Time.GoString()
+func locationGoString(loc *time.Location) string {
+	switch loc {
+	case time.UTC:
+		return "time.UTC"
+	case time.Local:
+		return "time.Local"
+	default:
+		return "time.Location(" + strconv.Quote(loc.String()) + ")"
+	}
+}
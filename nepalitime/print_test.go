@@ -0,0 +1,83 @@
+package nepalitime_test
+
+import (
+	"bytes"
+	"fmt"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/opensource-nepal/go-nepali/nepalitime"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNepaliTimeGoString(t *testing.T) {
+	nt, _ := nepalitime.Date(2079, 10, 6, 1, 0, 5, 10)
+	assert.Equal(t, "nepalitime.Date(2079, 10, 6, 1, 0, 5, 10)", fmt.Sprintf("%#v", nt))
+
+	nt, _ = nepalitime.DateIn(2079, 10, 6, 1, 0, 5, 0, time.UTC)
+	assert.Equal(t, "nepalitime.DateIn(2079, 10, 6, 1, 0, 5, 0, time.UTC)", nt.GoString())
+
+	var nilTime *nepalitime.NepaliTime
+	assert.Equal(t, "(*nepalitime.NepaliTime)(nil)", nilTime.GoString())
+}
+
+func TestNepaliTimePrintable(t *testing.T) {
+	nt, _ := nepalitime.Date(2079, 10, 6, 1, 0, 5, 0)
+	p := nt.Printable()
+
+	assert.Equal(t, "2079-10-06 01:00:05", fmt.Sprintf("%v", p))
+	assert.Equal(t, "2079-10-06 01:00:05", fmt.Sprintf("%s", p))
+	assert.Equal(t, `"2079-10-06 01:00:05"`, fmt.Sprintf("%q", p))
+	assert.Equal(t, "2079-10-06 01:00:05 BS (2023-01-20 01:00:05 AD) +0545 Asia/Kathmandu", fmt.Sprintf("%+v", p))
+	assert.Equal(t, "nepalitime.Date(2079, 10, 6, 1, 0, 5, 0)", fmt.Sprintf("%#v", p))
+	assert.Equal(t, "[2079-10-06 01:00:05   ]", fmt.Sprintf("[%-22v]", p))
+	assert.Equal(t, "%!d(2079-10-06 01:00:05)", fmt.Sprintf("%d", p))
+
+	var nilTime *nepalitime.NepaliTime
+	assert.Equal(t, "<nil>", fmt.Sprintf("%v", nilTime.Printable()))
+}
+
+func TestNepaliTimePlusVerbWithoutPrintable(t *testing.T) {
+	nt, _ := nepalitime.Date(2079, 10, 6, 1, 0, 5, 0)
+
+	// without Printable(), %+v is same as %v
+	assert.Equal(t, "2079-10-06 01:00:05", fmt.Sprintf("%+v", nt))
+}
+
+func TestNepaliTimePrintableWithHistoricalOffset(t *testing.T) {
+	nt, _ := nepalitime.Date(2040, 1, 1, 0, 0, 0, 0)
+	assert.Equal(t, "2040-01-01 00:00:00 BS (1983-04-14 00:00:00 AD) +0530 Asia/Kathmandu", fmt.Sprintf("%+v", nt.Printable()))
+}
+
+func TestNepaliDateFormat(t *testing.T) {
+	date := mustDate(t, 2079, 10, 14)
+
+	assert.Equal(t, "2079-10-14", fmt.Sprintf("%v", date))
+	assert.Equal(t, "2079-10-14", fmt.Sprintf("%s", date))
+	assert.Equal(t, "2079-10-14 BS (2023-01-28 AD)", fmt.Sprintf("%+v", date))
+	assert.Equal(t, "nepalitime.NewDate(2079, 10, 14)", fmt.Sprintf("%#v", date))
+	assert.Equal(t, "  2079-10-14", fmt.Sprintf("%12v", date))
+}
+
+func TestNepaliTimeLogValue(t *testing.T) {
+	nt, _ := nepalitime.Date(2079, 10, 6, 1, 0, 5, 0)
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if len(groups) == 0 && a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+	logger.Info("created", "time", nt, "date", nt.NepaliDate())
+
+	assert.Equal(
+		t,
+		`level=INFO msg=created time.bs="2079-10-06 01:00:05" time.ad=2023-01-20T01:00:05+05:45 time.unix=1674155705 date.bs=2079-10-06 date.ad=2023-01-20`,
+		strings.TrimSpace(buf.String()),
+	)
+}
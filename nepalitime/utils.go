@@ -1,7 +1,11 @@
 package nepalitime
 
 import (
+	"context"
+	_ "embed"
 	"fmt"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/opensource-nepal/go-nepali/constants"
@@ -11,20 +15,36 @@ import (
 // Date returns the Time corresponding to
 //
 // yyyy-mm-dd hh:mm:ss + nsec nanoseconds
+//
+// in Asia/Kathmandu.
 func Date(year, month, day, hour, min, sec, nsec int) (*NepaliTime, error) {
+	return DateIn(year, month, day, hour, min, sec, nsec, GetNepaliLocation())
+}
+
+// DateIn is same as Date() but the wall clock time is in the given location.
+// Like time.Date(), it panics if loc is nil.
+func DateIn(year, month, day, hour, min, sec, nsec int, loc *time.Location) (*NepaliTime, error) {
 	englishDate, err := dateConverter.NepaliToEnglish(year, month, day)
 	if err != nil {
 		return nil, err
 	}
 
 	englishTime := time.Date(englishDate[0], time.Month(englishDate[1]), englishDate[2],
-		hour, min, sec, nsec, GetNepaliLocation())
+		hour, min, sec, nsec, loc)
 	return &NepaliTime{year, month, day, &englishTime}, nil
 }
 
 // FromEnglishTime Converts Time object to NepaliTime
+// The nepali date is calculated in Asia/Kathmandu.
 func FromEnglishTime(englishTime time.Time) (*NepaliTime, error) {
-	englishTime = englishTime.In(GetNepaliLocation())
+	return FromEnglishTimeIn(englishTime, GetNepaliLocation())
+}
+
+// FromEnglishTimeIn is same as FromEnglishTime() but the nepali date
+// is calculated in the given location.
+// Like time.Time.In(), it panics if loc is nil.
+func FromEnglishTimeIn(englishTime time.Time, loc *time.Location) (*NepaliTime, error) {
+	englishTime = englishTime.In(loc)
 	enYear, enMonth, enDay := englishTime.Date()
 	englishDate, err := dateConverter.EnglishToNepali(enYear, int(enMonth), enDay)
 	if err != nil {
@@ -43,6 +63,14 @@ func Now() *NepaliTime {
 	return now
 }
 
+// NowContext returns the current nepali time of the clock carried by ctx.
+// See WithClock().
+func NowContext(ctx context.Context) *NepaliTime {
+	now, _ := FromEnglishTime(GetCurrentEnglishTimeContext(ctx))
+
+	return now
+}
+
 // adds zero on the number if the number is less than 10
 // Converts single digit number into two digits.
 // Adds zero on the number if the number is less than 10.
@@ -57,15 +85,78 @@ func twoDigitNumber(number int) string {
 	return fmt.Sprint(number)
 }
 
+// alternative spellings of the devanagari month names accepted while parsing
+var devanagariMonthAliases = map[string]int{
+	"वैशाख":   1,
+	"जेष्ठ":   2,
+	"आषाढ":    3,
+	"श्रावण":  4,
+	"भाद्र":   5,
+	"आश्विन":  6,
+	"कार्तिक": 7,
+	"मार्ग":   8,
+	"पौष":     9,
+	"फाल्गुन": 11,
+	"चैत्र":   12,
+}
+
+// returns the month number (1 to 12) of the english or devanagari month name,
+// 0 if the name is not a month
+func monthFromName(name string) int {
+	for i := range constants.NepaliMonths {
+		if strings.EqualFold(constants.NepaliMonths[i], name) || constants.DevanagariMonths[i] == name {
+			return i + 1
+		}
+	}
+
+	return devanagariMonthAliases[name]
+}
+
 // GetCurrentEnglishTime Gets current English date along with time level precision.
 // Current Time of Asia/Kathmandu
 func GetCurrentEnglishTime() time.Time {
-	return time.Now().In(GetNepaliLocation())
+	return GetClock().Now().In(GetNepaliLocation())
+}
+
+// GetCurrentEnglishTimeContext Gets current English time of Asia/Kathmandu
+// from the clock carried by ctx. See WithClock().
+func GetCurrentEnglishTimeContext(ctx context.Context) time.Time {
+	return ClockFromContext(ctx).Now().In(GetNepaliLocation())
 }
 
+// tzdata of Asia/Kathmandu, used when the system doesn't have zoneinfo
+// (eg. distroless or scratch containers).
+// It includes the historical offset +05:30 used before 1986.
+//
+//go:embed zoneinfo/Asia/Kathmandu
+var embeddedNepaliTZData []byte
+
+var (
+	nepaliLocation     *time.Location
+	nepaliLocationOnce sync.Once
+)
+
 // GetNepaliLocation Returns location for Asia/Kathmandu (constants.Timezone)
+//
+// The system zoneinfo is used when available, else the embedded tzdata.
+// So it always returns a valid location.
 func GetNepaliLocation() *time.Location {
-	location, _ := time.LoadLocation(constants.Timezone)
-	// ignoring error since location with Asia/Kathmandu will not fail.
+	nepaliLocationOnce.Do(func() {
+		location, err := time.LoadLocation(constants.Timezone)
+		if err != nil {
+			location = loadEmbeddedNepaliLocation()
+		}
+		nepaliLocation = location
+	})
+
+	return nepaliLocation
+}
+
+func loadEmbeddedNepaliLocation() *time.Location {
+	location, err := time.LoadLocationFromTZData(constants.Timezone, embeddedNepaliTZData)
+	if err != nil {
+		// the embedded data is verified by the tests
+		panic("nepalitime: invalid embedded tzdata: " + err.Error())
+	}
 	return location
 }
@@ -1,6 +1,7 @@
 package nepalitime_test
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -60,16 +61,115 @@ func TestFromEnglishTimeWithNewYorkTimezone(t *testing.T) {
 func TestNow(t *testing.T) {
 	npTime := nepalitime.Now()
 	assert.NotNil(t, npTime)
-	// TODO: Mocking needed for further check
+}
+
+func TestNowWithFakeClock(t *testing.T) {
+	clock := nepalitime.NewFakeClock(time.Date(2023, 1, 28, 16, 23, 17, 0, nepalitime.GetNepaliLocation()))
+	defer nepalitime.SetClock(clock)()
+
+	assert.Equal(t, "2079-10-14 16:23:17", nepalitime.Now().String())
+}
+
+func TestNowContext(t *testing.T) {
+	clock := nepalitime.NewFakeClock(time.Date(2023, 1, 28, 16, 23, 17, 0, time.UTC))
+	ctx := nepalitime.WithClock(context.Background(), clock)
+
+	assert.Equal(t, "2079-10-14 22:08:17", nepalitime.NowContext(ctx).String())
 }
 
 func TestGetCurrentEnglishTime(t *testing.T) {
 	enTime := nepalitime.GetCurrentEnglishTime()
 	assert.NotNil(t, enTime)
-	// TODO: Mocking needed for further check
+	assert.Equal(t, constants.Timezone, enTime.Location().String())
+}
+
+func TestGetCurrentEnglishTimeWithFakeClock(t *testing.T) {
+	clock := nepalitime.NewFakeClock(time.Date(2023, 1, 28, 10, 0, 0, 0, time.UTC))
+	defer nepalitime.SetClock(clock)()
+
+	enTime := nepalitime.GetCurrentEnglishTime()
+	assert.Equal(t, 15, enTime.Hour())
+	assert.Equal(t, 45, enTime.Minute())
+	assert.Equal(t, constants.Timezone, enTime.Location().String())
 }
 
 func TestGetNepaliLocation(t *testing.T) {
 	loc := nepalitime.GetNepaliLocation()
 	assert.Equal(t, loc.String(), constants.Timezone)
 }
+
+func TestGetNepaliLocationIsCached(t *testing.T) {
+	assert.Same(t, nepalitime.GetNepaliLocation(), nepalitime.GetNepaliLocation())
+}
+
+func TestEmbeddedNepaliLocation(t *testing.T) {
+	loc := nepalitime.LoadEmbeddedNepaliLocation()
+	assert.Equal(t, constants.Timezone, loc.String())
+
+	_, offset := time.Date(1985, 12, 31, 0, 0, 0, 0, loc).Zone()
+	assert.Equal(t, 5*60*60+30*60, offset)
+
+	_, offset = time.Date(2023, 1, 28, 0, 0, 0, 0, loc).Zone()
+	assert.Equal(t, 5*60*60+45*60, offset)
+}
+
+func TestDateIn(t *testing.T) {
+	nt, err := nepalitime.DateIn(2079, 10, 14, 16, 23, 17, 0, time.UTC)
+	assert.Nil(t, err)
+	assert.Equal(t, "2079-10-14 16:23:17", nt.String())
+	assert.Equal(t, time.UTC, nt.GetEnglishTime().Location())
+}
+
+func TestDateInWithInvalidDate(t *testing.T) {
+	nt, err := nepalitime.DateIn(2079, 1, 40, 0, 0, 0, 0, time.UTC)
+	assert.Nil(t, nt)
+	assert.NotNil(t, err)
+}
+
+func TestFromEnglishTimeIn(t *testing.T) {
+	// 2079/10/14 11:00 PM in Nepal, but 2079/10/14 5:15 PM in UTC
+	loc, _ := time.LoadLocation("Asia/Singapore")
+	enTime := time.Date(2023, 1, 29, 1, 15, 0, 0, loc)
+
+	nt, err := nepalitime.FromEnglishTimeIn(enTime, time.UTC)
+	assert.Nil(t, err)
+	assert.Equal(t, "2079-10-14 17:15:00", nt.String())
+
+	nt, err = nepalitime.FromEnglishTimeIn(enTime, loc)
+	assert.Nil(t, err)
+	assert.Equal(t, "2079-10-15 01:15:00", nt.String())
+}
+
+// Nepal changed the UTC offset from +05:30 to +05:45 on 1986/01/01 (2042/09/17 BS),
+// the wall clock jumped from 00:00 to 00:15.
+
+func TestFromEnglishTimeBeforeOffsetTransition(t *testing.T) {
+	// 00:05 on 2042/09/17 with +05:45, but 23:50 on 2042/09/16 with +05:30
+	nt, err := nepalitime.FromEnglishTime(time.Date(1985, 12, 31, 18, 20, 0, 0, time.UTC))
+	assert.Nil(t, err)
+	assert.Equal(t, "2042-09-16 23:50:00", nt.String())
+}
+
+func TestFromEnglishTimeAfterOffsetTransition(t *testing.T) {
+	nt, err := nepalitime.FromEnglishTime(time.Date(1985, 12, 31, 18, 30, 0, 0, time.UTC))
+	assert.Nil(t, err)
+	assert.Equal(t, "2042-09-17 00:15:00", nt.String())
+}
+
+func TestDateBeforeOffsetTransition(t *testing.T) {
+	nt, err := nepalitime.Date(2042, 9, 16, 23, 59, 0, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, time.Date(1985, 12, 31, 18, 29, 0, 0, time.UTC), nt.GetEnglishTime().UTC())
+}
+
+func TestDateAfterOffsetTransition(t *testing.T) {
+	nt, err := nepalitime.Date(2042, 9, 17, 0, 15, 0, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, time.Date(1985, 12, 31, 18, 30, 0, 0, time.UTC), nt.GetEnglishTime().UTC())
+}
+
+func TestDateUsesHistoricalOffsetForOldDates(t *testing.T) {
+	nt, err := nepalitime.Date(2000, 1, 1, 0, 0, 0, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, time.Date(1943, 4, 13, 18, 30, 0, 0, time.UTC), nt.GetEnglishTime().UTC())
+}
@@ -0,0 +1,56 @@
+package nepalitime
+
+// YearPivot resolves a two-digit year parsed by %y into a full year.
+//
+// The nil YearPivot keeps the year in 2000-2099, the range supported by dateConverter.
+// Years resolved outside of the supported range are rejected while parsing
+// with the out of range error, until the older data is added to dateConverter.
+type YearPivot func(twoDigitYear int) int
+
+// FixedYearPivot returns the YearPivot which resolves the two-digit years
+// less than the cutoff into 2000s and the rest into 1900s.
+//
+// eg. with FixedYearPivot(90), "79" is 2079 and "99" is 1999.
+func FixedYearPivot(cutoff int) YearPivot {
+	return func(twoDigitYear int) int {
+		if twoDigitYear < cutoff {
+			return 2000 + twoDigitYear
+		}
+		return 1900 + twoDigitYear
+	}
+}
+
+// SlidingYearPivot returns the YearPivot which resolves the two-digit year
+// into the 100 years window ending yearsAhead years after the current nepali year.
+// The current year is read at the time of parsing, see SetClock().
+//
+// eg. in 2080 with SlidingYearPivot(20), the window is 2001 to 2100,
+// so "79" is 2079 and "00" is 2100.
+func SlidingYearPivot(yearsAhead int) YearPivot {
+	return func(twoDigitYear int) int {
+		now := Now()
+		if now == nil {
+			return defaultYearPivot(twoDigitYear)
+		}
+
+		last := now.Year() + yearsAhead
+		year := last - last%100 + twoDigitYear
+		if year > last {
+			year -= 100
+		}
+		return year
+	}
+}
+
+// %y is in 2000s by default
+func defaultYearPivot(twoDigitYear int) int {
+	return 2000 + twoDigitYear
+}
+
+// resolves the two-digit year with the pivot, default if nil
+func (pivot YearPivot) resolve(twoDigitYear int) int {
+	if pivot == nil {
+		return defaultYearPivot(twoDigitYear)
+	}
+	return pivot(twoDigitYear)
+}
@@ -0,0 +1,71 @@
+package nepalitime_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/opensource-nepal/go-nepali/dateConverter"
+	"github.com/opensource-nepal/go-nepali/nepalitime"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFixedYearPivot(t *testing.T) {
+	pivot := nepalitime.FixedYearPivot(90)
+
+	assert.Equal(t, 2000, pivot(0))
+	assert.Equal(t, 2079, pivot(79))
+	assert.Equal(t, 2089, pivot(89))
+	assert.Equal(t, 1990, pivot(90))
+	assert.Equal(t, 1999, pivot(99))
+}
+
+func TestSlidingYearPivot(t *testing.T) {
+	// 2080-06-15 BS
+	restore := nepalitime.SetClock(nepalitime.NewFakeClock(time.Date(2023, 10, 2, 0, 0, 0, 0, time.UTC)))
+	defer restore()
+
+	pivot := nepalitime.SlidingYearPivot(20)
+
+	assert.Equal(t, 2001, pivot(1))
+	assert.Equal(t, 2079, pivot(79))
+	assert.Equal(t, 2099, pivot(99))
+	assert.Equal(t, 2100, pivot(0))
+
+	pivot = nepalitime.SlidingYearPivot(0)
+
+	assert.Equal(t, 2080, pivot(80))
+	assert.Equal(t, 1981, pivot(81))
+}
+
+func TestParseWithYearPivot(t *testing.T) {
+	opts := nepalitime.ParseOptions{YearPivot: nepalitime.FixedYearPivot(90)}
+
+	got, err := nepalitime.ParseWithOptions("79/10/14", "%y/%m/%d", opts)
+	assert.Nil(t, err, "error should be nil")
+	assert.Equal(t, "2079-10-14 00:00:00", got.String())
+
+	// 1999 BS is out of the range of dateConverter
+	_, err = nepalitime.ParseWithOptions("99/01/01", "%y/%m/%d", opts)
+	assert.ErrorIs(t, err, dateConverter.ErrOutOfRange)
+}
+
+func TestParseWithDefaultYearPivot(t *testing.T) {
+	got, err := nepalitime.Parse("99/01/01", "%y/%m/%d")
+	assert.Nil(t, err, "error should be nil")
+	assert.Equal(t, 2099, got.Year())
+}
+
+func TestLayoutWithYearPivot(t *testing.T) {
+	opts := nepalitime.ParseOptions{YearPivot: nepalitime.FixedYearPivot(80)}
+	layout := nepalitime.MustCompileLayout("%y-%m-%d")
+
+	got, err := layout.Parse("79-10-14")
+	assert.Nil(t, err, "error should be nil")
+	assert.Equal(t, 2079, got.Year())
+
+	layout, err = nepalitime.CompileLayoutWithOptions("%y-%m-%d", opts)
+	assert.Nil(t, err, "error should be nil")
+
+	_, err = layout.Parse("80-10-14")
+	assert.ErrorIs(t, err, dateConverter.ErrOutOfRange)
+}
@@ -0,0 +1,461 @@
+// Package recurrence
+// This package contains the recurrence rules (RRULE of RFC 5545) evaluated in the nepali calendar.
+//
+// The months and the month days of the rule are nepali (BS), eg.
+// "FREQ=YEARLY;BYMONTH=1;BYMONTHDAY=1" is every Baisakh 1 and
+// "FREQ=MONTHLY;BYMONTHDAY=-1" is the last day (29 to 32) of every nepali month.
+//
+// USAGE:
+//
+//	rule, err := recurrence.Parse("FREQ=MONTHLY;BYMONTHDAY=15", start)
+//	for occurrence := range rule.All() {
+//		...
+//	}
+package recurrence
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/opensource-nepal/go-nepali/dateConverter"
+	"github.com/opensource-nepal/go-nepali/nepalitime"
+)
+
+// Frequency of the rule, FREQ of RRULE
+type Frequency int
+
+const (
+	Daily Frequency = iota
+	Weekly
+	Monthly
+	Yearly
+)
+
+var frequencyNames = [...]string{"DAILY", "WEEKLY", "MONTHLY", "YEARLY"}
+
+func (f Frequency) String() string {
+	if f < Daily || f > Yearly {
+		return fmt.Sprintf("Frequency(%d)", int(f))
+	}
+	return frequencyNames[f]
+}
+
+var weekdayNames = [...]string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+
+// Weekday of BYDAY, eg. "MO", "2MO" (second monday) or "-1FR" (last friday).
+type Weekday struct {
+	Weekday time.Weekday
+
+	// N is the occurrence of the weekday in the nepali month, eg. -1 for the last.
+	// 0 is every occurrence.
+	N int
+}
+
+func (w Weekday) String() string {
+	if w.N == 0 {
+		return weekdayNames[w.Weekday]
+	}
+	return strconv.Itoa(w.N) + weekdayNames[w.Weekday]
+}
+
+// Rule is a recurrence rule in the nepali calendar.
+//
+// It differs from RFC 5545 in the following ways:
+//   - BYMONTH and BYMONTHDAY are nepali months and days.
+//   - The ordinal of BYDAY (eg. -1FR) is always counted in the nepali month.
+//   - The weeks start on Sunday, WKST and BYSETPOS are not supported.
+//   - Start is counted by COUNT only if it matches the rule.
+//
+// The days which don't exist in a month (eg. BYMONTHDAY=32 in a 30 days month)
+// are skipped, use -1 for the last day of the month.
+type Rule struct {
+	// Start of the recurrence (DTSTART), the occurrences have the time of day
+	// and the location of Start.
+	Start *nepalitime.NepaliTime
+
+	Freq     Frequency
+	Interval int // 1 if 0
+
+	// Count is the maximum number of occurrences, unlimited if 0.
+	Count int
+
+	// Until is the last time of the occurrences (inclusive), unlimited if nil.
+	Until *nepalitime.NepaliTime
+
+	ByMonth    []int // 1 to 12
+	ByMonthDay []int // 1 to 32 or -32 to -1
+	ByDay      []Weekday
+}
+
+// Parse parses the RRULE, eg. "FREQ=MONTHLY;INTERVAL=2;BYMONTHDAY=-1".
+// The "RRULE:" prefix is optional.
+// UNTIL is a nepali date "20801231" or time "20801231T235959" in the location of start.
+func Parse(rrule string, start *nepalitime.NepaliTime) (*Rule, error) {
+	if start == nil {
+		return nil, errors.New("start of the rule is required")
+	}
+
+	rule := &Rule{Start: start, Freq: -1}
+	rrule = strings.TrimPrefix(strings.TrimSpace(rrule), "RRULE:")
+
+	for _, part := range strings.Split(rrule, ";") {
+		key, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid rule part %q", part)
+		}
+
+		var err error
+		switch strings.ToUpper(key) {
+		case "FREQ":
+			rule.Freq, err = parseFrequency(value)
+		case "INTERVAL":
+			rule.Interval, err = parsePositiveInt(value)
+		case "COUNT":
+			rule.Count, err = parsePositiveInt(value)
+		case "UNTIL":
+			rule.Until, err = parseUntil(value, start.GetEnglishTime().Location())
+		case "BYMONTH":
+			rule.ByMonth, err = parseIntList(value, 1, 12, false)
+		case "BYMONTHDAY":
+			rule.ByMonthDay, err = parseIntList(value, 1, 32, true)
+		case "BYDAY":
+			rule.ByDay, err = parseWeekdays(value)
+		default:
+			return nil, fmt.Errorf("unsupported rule part %s", key)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid value in %s: %w", strings.ToUpper(key), err)
+		}
+	}
+
+	if rule.Freq < 0 {
+		return nil, errors.New("FREQ is required")
+	}
+	if rule.Count > 0 && rule.Until != nil {
+		return nil, errors.New("COUNT and UNTIL can't be used together")
+	}
+
+	return rule, nil
+}
+
+// String returns the rule in the RRULE form, without the "RRULE:" prefix.
+func (r *Rule) String() string {
+	parts := []string{"FREQ=" + r.Freq.String()}
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+	if r.Until != nil {
+		parts = append(parts, "UNTIL="+r.Until.Format("%Y%m%dT%H%M%S"))
+	}
+	if len(r.ByMonth) > 0 {
+		parts = append(parts, "BYMONTH="+joinInts(r.ByMonth))
+	}
+	if len(r.ByMonthDay) > 0 {
+		parts = append(parts, "BYMONTHDAY="+joinInts(r.ByMonthDay))
+	}
+	if len(r.ByDay) > 0 {
+		days := make([]string, len(r.ByDay))
+		for i, day := range r.ByDay {
+			days[i] = day.String()
+		}
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+
+	return strings.Join(parts, ";")
+}
+
+// All returns an iterator over the occurrences of the rule in order.
+// It stops at Count, Until or the end of the supported range of the nepali calendar.
+func (r *Rule) All() iter.Seq[*nepalitime.NepaliTime] {
+	return func(yield func(*nepalitime.NepaliTime) bool) {
+		startDate := r.Start.NepaliDate()
+		hour, min, sec := r.Start.Clock()
+		nsec := r.Start.Nanosecond()
+		loc := r.Start.GetEnglishTime().Location()
+
+		count := 0
+		for period := 0; ; period++ {
+			dates, ok := r.periodDates(period)
+			if !ok {
+				return
+			}
+
+			for _, date := range dates {
+				if date.Before(startDate) {
+					continue
+				}
+
+				year, month, day := date.Date()
+				occurrence, err := nepalitime.DateIn(year, month, day, hour, min, sec, nsec, loc)
+				if err != nil {
+					return
+				}
+				if r.Until != nil && occurrence.GetEnglishTime().After(r.Until.GetEnglishTime()) {
+					return
+				}
+				if !yield(occurrence) {
+					return
+				}
+
+				count++
+				if r.Count > 0 && count >= r.Count {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Between returns the occurrences from after to before, both inclusive.
+func (r *Rule) Between(after, before *nepalitime.NepaliTime) []*nepalitime.NepaliTime {
+	var occurrences []*nepalitime.NepaliTime
+	for occurrence := range r.All() {
+		if occurrence.GetEnglishTime().After(before.GetEnglishTime()) {
+			break
+		}
+		if !occurrence.GetEnglishTime().Before(after.GetEnglishTime()) {
+			occurrences = append(occurrences, occurrence)
+		}
+	}
+	return occurrences
+}
+
+// Next returns the first occurrence after the time t (exclusive),
+// nil if there are no more occurrences.
+func (r *Rule) Next(t *nepalitime.NepaliTime) *nepalitime.NepaliTime {
+	for occurrence := range r.All() {
+		if occurrence.GetEnglishTime().After(t.GetEnglishTime()) {
+			return occurrence
+		}
+	}
+	return nil
+}
+
+func (r *Rule) interval() int {
+	if r.Interval < 1 {
+		return 1
+	}
+	return r.Interval
+}
+
+// returns the sorted dates of the nth period of the frequency,
+// false if the period is out of the supported range
+func (r *Rule) periodDates(n int) ([]nepalitime.NepaliDate, bool) {
+	start := r.Start.NepaliDate()
+	step := n * r.interval()
+
+	var dates []nepalitime.NepaliDate
+	switch r.Freq {
+	case Daily:
+		date, err := start.AddDays(step)
+		if err != nil {
+			return nil, false
+		}
+		dates = []nepalitime.NepaliDate{date}
+	case Weekly:
+		weekStart, err := start.StartOf(nepalitime.Week)
+		if err == nil {
+			weekStart, err = weekStart.AddDays(7 * step)
+		}
+		if err != nil {
+			return nil, false
+		}
+
+		weekdays := r.ByDay
+		if len(weekdays) == 0 {
+			weekdays = []Weekday{{Weekday: start.Weekday()}}
+		}
+		for i := range 7 {
+			date, err := weekStart.AddDays(i)
+			if err != nil {
+				break
+			}
+			if matchesWeekday(date, weekdays) {
+				dates = append(dates, date)
+			}
+		}
+	case Monthly:
+		date, err := start.AddMonths(step)
+		if err != nil {
+			return nil, false
+		}
+		dates = r.monthDates(date.Year(), date.Month())
+	case Yearly:
+		year := start.Year() + step
+		if _, err := dateConverter.NepaliYearDays(year); err != nil {
+			return nil, false
+		}
+
+		months := r.ByMonth
+		if len(months) == 0 {
+			if len(r.ByMonthDay) > 0 || len(r.ByDay) > 0 {
+				months = []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12}
+			} else {
+				months = []int{start.Month()}
+			}
+		}
+		for _, month := range months {
+			dates = append(dates, r.monthDates(year, month)...)
+		}
+	default:
+		return nil, false
+	}
+
+	dates = slices.DeleteFunc(dates, func(date nepalitime.NepaliDate) bool { return !r.matches(date) })
+	slices.SortFunc(dates, nepalitime.NepaliDate.Compare)
+	return slices.Compact(dates), true
+}
+
+// returns the dates of the month expanded from BYMONTHDAY, BYDAY or the day of Start
+func (r *Rule) monthDates(year, month int) []nepalitime.NepaliDate {
+	days, _ := dateConverter.NepaliMonthDays(year, month)
+
+	var dates []nepalitime.NepaliDate
+	switch {
+	case len(r.ByMonthDay) > 0:
+		for _, day := range r.ByMonthDay {
+			if day < 0 {
+				day += days + 1
+			}
+			// the days which don't exist in the month are skipped
+			if date, err := nepalitime.NewDate(year, month, day); err == nil {
+				dates = append(dates, date)
+			}
+		}
+	case len(r.ByDay) > 0:
+		for day := 1; day <= days; day++ {
+			date, _ := nepalitime.NewDate(year, month, day)
+			if matchesWeekday(date, r.ByDay) {
+				dates = append(dates, date)
+			}
+		}
+	default:
+		if date, err := nepalitime.NewDate(year, month, r.Start.Day()); err == nil {
+			dates = append(dates, date)
+		}
+	}
+
+	return dates
+}
+
+// checks the date against the BYMONTH, BYMONTHDAY and BYDAY filters
+func (r *Rule) matches(date nepalitime.NepaliDate) bool {
+	if len(r.ByMonth) > 0 && !slices.Contains(r.ByMonth, date.Month()) {
+		return false
+	}
+	if len(r.ByMonthDay) > 0 {
+		fromEnd := date.Day() - date.DaysInMonth() - 1
+		if !slices.Contains(r.ByMonthDay, date.Day()) && !slices.Contains(r.ByMonthDay, fromEnd) {
+			return false
+		}
+	}
+	if len(r.ByDay) > 0 && !matchesWeekday(date, r.ByDay) {
+		return false
+	}
+	return true
+}
+
+// checks if the date is one of the weekdays,
+// the ordinal of the weekday is counted in the nepali month
+func matchesWeekday(date nepalitime.NepaliDate, weekdays []Weekday) bool {
+	nth := (date.Day()-1)/7 + 1
+	nthFromEnd := -((date.DaysInMonth()-date.Day())/7 + 1)
+
+	for _, weekday := range weekdays {
+		if weekday.Weekday != date.Weekday() {
+			continue
+		}
+		if weekday.N == 0 || weekday.N == nth || weekday.N == nthFromEnd {
+			return true
+		}
+	}
+	return false
+}
+
+func parseFrequency(value string) (Frequency, error) {
+	for i, name := range frequencyNames {
+		if strings.EqualFold(name, value) {
+			return Frequency(i), nil
+		}
+	}
+	return 0, fmt.Errorf("unknown frequency %q", value)
+}
+
+func parsePositiveInt(value string) (int, error) {
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 1 {
+		return 0, fmt.Errorf("%q is not a positive number", value)
+	}
+	return n, nil
+}
+
+// parses the comma separated numbers in the range [min, max],
+// or [-max, -min] too if negative is true
+func parseIntList(value string, min, max int, negative bool) ([]int, error) {
+	var numbers []int
+	for _, part := range strings.Split(value, ",") {
+		n, err := strconv.Atoi(part)
+		abs := n
+		if n < 0 && negative {
+			abs = -n
+		}
+		if err != nil || abs < min || abs > max {
+			return nil, fmt.Errorf("%q is out of range", part)
+		}
+		numbers = append(numbers, n)
+	}
+	return numbers, nil
+}
+
+func parseWeekdays(value string) ([]Weekday, error) {
+	var weekdays []Weekday
+	for _, part := range strings.Split(value, ",") {
+		part = strings.ToUpper(part)
+		if len(part) < 2 {
+			return nil, fmt.Errorf("unknown weekday %q", part)
+		}
+
+		index := slices.Index(weekdayNames[:], part[len(part)-2:])
+		if index == -1 {
+			return nil, fmt.Errorf("unknown weekday %q", part)
+		}
+
+		weekday := Weekday{Weekday: time.Weekday(index)}
+		if ordinal := part[:len(part)-2]; ordinal != "" {
+			n, err := strconv.Atoi(ordinal)
+			if err != nil || n == 0 || n < -5 || n > 5 {
+				return nil, fmt.Errorf("invalid weekday ordinal %q", part)
+			}
+			weekday.N = n
+		}
+		weekdays = append(weekdays, weekday)
+	}
+	return weekdays, nil
+}
+
+// parses the nepali date "20801231" or time "20801231T235959"
+func parseUntil(value string, loc *time.Location) (*nepalitime.NepaliTime, error) {
+	if len(value) == len("20060102") {
+		value += "T235959"
+	}
+	return nepalitime.ParseWithOptions(value, "%Y%m%dT%H%M%S", nepalitime.ParseOptions{
+		Mode:     nepalitime.StrictMode,
+		Location: loc,
+	})
+}
+
+func joinInts(numbers []int) string {
+	parts := make([]string, len(numbers))
+	for i, n := range numbers {
+		parts[i] = strconv.Itoa(n)
+	}
+	return strings.Join(parts, ",")
+}
@@ -0,0 +1,153 @@
+package recurrence_test
+
+import (
+	"testing"
+
+	"github.com/opensource-nepal/go-nepali/nepalitime"
+	"github.com/opensource-nepal/go-nepali/recurrence"
+	"github.com/stretchr/testify/assert"
+)
+
+func mustTime(t *testing.T, year, month, day, hour int) *nepalitime.NepaliTime {
+	t.Helper()
+
+	nt, err := nepalitime.Date(year, month, day, hour, 0, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return nt
+}
+
+func occurrences(t *testing.T, rrule string, start *nepalitime.NepaliTime) []string {
+	t.Helper()
+
+	rule, err := recurrence.Parse(rrule, start)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var result []string
+	for occurrence := range rule.All() {
+		result = append(result, occurrence.String())
+	}
+	return result
+}
+
+func TestEveryBaisakhOne(t *testing.T) {
+	got := occurrences(t, "FREQ=YEARLY;BYMONTH=1;BYMONTHDAY=1;COUNT=3", mustTime(t, 2080, 1, 1, 9))
+	assert.Equal(t, []string{"2080-01-01 09:00:00", "2081-01-01 09:00:00", "2082-01-01 09:00:00"}, got)
+
+	// same month and day as the start
+	got = occurrences(t, "RRULE:FREQ=YEARLY;COUNT=2", mustTime(t, 2080, 1, 1, 9))
+	assert.Equal(t, []string{"2080-01-01 09:00:00", "2081-01-01 09:00:00"}, got)
+}
+
+func TestLastDayOfEveryMonth(t *testing.T) {
+	got := occurrences(t, "FREQ=MONTHLY;BYMONTHDAY=-1;COUNT=12", mustTime(t, 2080, 1, 1, 0))
+	assert.Equal(t, []string{
+		"2080-01-31 00:00:00", "2080-02-32 00:00:00", "2080-03-31 00:00:00", "2080-04-32 00:00:00",
+		"2080-05-31 00:00:00", "2080-06-30 00:00:00", "2080-07-30 00:00:00", "2080-08-30 00:00:00",
+		"2080-09-29 00:00:00", "2080-10-29 00:00:00", "2080-11-30 00:00:00", "2080-12-30 00:00:00",
+	}, got)
+}
+
+func TestFifteenthOfEveryMonth(t *testing.T) {
+	got := occurrences(t, "FREQ=MONTHLY;BYMONTHDAY=15;COUNT=3", mustTime(t, 2080, 1, 20, 0))
+	assert.Equal(t, []string{"2080-02-15 00:00:00", "2080-03-15 00:00:00", "2080-04-15 00:00:00"}, got)
+
+	// day of the start
+	got = occurrences(t, "FREQ=MONTHLY;INTERVAL=2;COUNT=3", mustTime(t, 2080, 1, 15, 0))
+	assert.Equal(t, []string{"2080-01-15 00:00:00", "2080-03-15 00:00:00", "2080-05-15 00:00:00"}, got)
+}
+
+func TestMissingMonthDaysAreSkipped(t *testing.T) {
+	got := occurrences(t, "FREQ=MONTHLY;BYMONTHDAY=32;COUNT=2", mustTime(t, 2080, 1, 1, 0))
+	assert.Equal(t, []string{"2080-02-32 00:00:00", "2080-04-32 00:00:00"}, got)
+
+	got = occurrences(t, "FREQ=MONTHLY;COUNT=2", mustTime(t, 2080, 2, 32, 0))
+	assert.Equal(t, []string{"2080-02-32 00:00:00", "2080-04-32 00:00:00"}, got)
+
+	// Poush 2080 and 2081 have 29 days
+	got = occurrences(t, "FREQ=YEARLY;BYMONTH=9;BYMONTHDAY=30;COUNT=1", mustTime(t, 2080, 1, 1, 0))
+	assert.Equal(t, []string{"2082-09-30 00:00:00"}, got)
+}
+
+func TestWeekly(t *testing.T) {
+	// 2080-04-01 is Monday
+	got := occurrences(t, "FREQ=WEEKLY;BYDAY=SU,WE;COUNT=4", mustTime(t, 2080, 4, 1, 0))
+	assert.Equal(t, []string{"2080-04-03 00:00:00", "2080-04-07 00:00:00", "2080-04-10 00:00:00", "2080-04-14 00:00:00"}, got)
+
+	got = occurrences(t, "FREQ=WEEKLY;INTERVAL=2;COUNT=2", mustTime(t, 2080, 4, 1, 0))
+	assert.Equal(t, []string{"2080-04-01 00:00:00", "2080-04-15 00:00:00"}, got)
+}
+
+func TestWeekdayOrdinalInMonth(t *testing.T) {
+	// fridays of Baisakh 2080 are 1, 8, 15, 22 and 29
+	got := occurrences(t, "FREQ=MONTHLY;BYDAY=-1FR;COUNT=1", mustTime(t, 2080, 1, 1, 0))
+	assert.Equal(t, []string{"2080-01-29 00:00:00"}, got)
+
+	got = occurrences(t, "FREQ=MONTHLY;BYDAY=2FR;COUNT=1", mustTime(t, 2080, 1, 1, 0))
+	assert.Equal(t, []string{"2080-01-08 00:00:00"}, got)
+}
+
+func TestDailyUntil(t *testing.T) {
+	got := occurrences(t, "FREQ=DAILY;INTERVAL=10;UNTIL=20800130", mustTime(t, 2080, 1, 1, 10))
+	assert.Equal(t, []string{"2080-01-01 10:00:00", "2080-01-11 10:00:00", "2080-01-21 10:00:00"}, got)
+
+	got = occurrences(t, "FREQ=DAILY;BYMONTH=1;BYDAY=SA;UNTIL=20800131T000000", mustTime(t, 2080, 1, 1, 0))
+	assert.Equal(t, []string{"2080-01-02 00:00:00", "2080-01-09 00:00:00", "2080-01-16 00:00:00", "2080-01-23 00:00:00", "2080-01-30 00:00:00"}, got)
+}
+
+func TestEndOfSupportedRange(t *testing.T) {
+	got := occurrences(t, "FREQ=YEARLY", mustTime(t, 2097, 1, 1, 0))
+	assert.Equal(t, []string{"2097-01-01 00:00:00", "2098-01-01 00:00:00", "2099-01-01 00:00:00"}, got)
+}
+
+func TestBetweenAndNext(t *testing.T) {
+	rule, err := recurrence.Parse("FREQ=MONTHLY;BYMONTHDAY=15", mustTime(t, 2080, 1, 1, 9))
+	assert.Nil(t, err, "error should be nil")
+
+	var got []string
+	for _, occurrence := range rule.Between(mustTime(t, 2080, 5, 15, 9), mustTime(t, 2080, 7, 30, 0)) {
+		got = append(got, occurrence.String())
+	}
+	assert.Equal(t, []string{"2080-05-15 09:00:00", "2080-06-15 09:00:00", "2080-07-15 09:00:00"}, got)
+
+	assert.Equal(t, "2080-06-15 09:00:00", rule.Next(mustTime(t, 2080, 5, 15, 9)).String())
+
+	rule, _ = recurrence.Parse("FREQ=MONTHLY;COUNT=1", mustTime(t, 2080, 1, 1, 9))
+	assert.Nil(t, rule.Next(mustTime(t, 2080, 1, 1, 9)))
+}
+
+func TestRuleString(t *testing.T) {
+	rrule := "FREQ=MONTHLY;INTERVAL=2;UNTIL=20801230T235959;BYMONTH=1,4;BYMONTHDAY=1,-1;BYDAY=MO,-1FR"
+	rule, err := recurrence.Parse(rrule, mustTime(t, 2080, 1, 1, 0))
+	assert.Nil(t, err, "error should be nil")
+	assert.Equal(t, rrule, rule.String())
+}
+
+func TestParseWithInvalidRule(t *testing.T) {
+	start := mustTime(t, 2080, 1, 1, 0)
+
+	for _, rrule := range []string{
+		"",
+		"BYMONTH=1",
+		"FREQ=HOURLY",
+		"FREQ=DAILY;INTERVAL=0",
+		"FREQ=DAILY;COUNT=x",
+		"FREQ=MONTHLY;BYMONTH=13",
+		"FREQ=MONTHLY;BYMONTHDAY=33",
+		"FREQ=MONTHLY;BYMONTHDAY=0",
+		"FREQ=MONTHLY;BYDAY=XY",
+		"FREQ=MONTHLY;BYDAY=6MO",
+		"FREQ=MONTHLY;UNTIL=20801399",
+		"FREQ=MONTHLY;COUNT=2;UNTIL=20801230",
+		"FREQ=MONTHLY;BYSETPOS=1",
+	} {
+		_, err := recurrence.Parse(rrule, start)
+		assert.NotNil(t, err, rrule)
+	}
+
+	_, err := recurrence.Parse("FREQ=DAILY", nil)
+	assert.NotNil(t, err, "error should not be nil")
+}